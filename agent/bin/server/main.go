@@ -1,12 +1,24 @@
 package main
 
 import (
+	"flag"
 	"github.com/csnewman/droidmole/agent/server"
 	"github.com/csnewman/droidmole/agent/server/adb"
 	"go.uber.org/zap"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func main() {
+	var config server.Config
+	flag.StringVar(&config.HTTPAddr, "http", "", "address to serve MJPEG display streams and snapshots on, e.g. :8081")
+	flag.StringVar(&config.VNCAddr, "vnc", "", "address to serve the main display over VNC on, e.g. :5900")
+	flag.StringVar(&config.VNCPassword, "vnc-password", "", "password required by VNC clients")
+	flag.DurationVar(&config.ShutdownTimeout, "shutdown-timeout", 30*time.Second, "time allowed for the emulator to stop when the agent shuts down")
+	flag.Parse()
+
 	logger, _ := zap.NewDevelopment()
 	defer logger.Sync()
 	sugar := logger.Sugar()
@@ -16,7 +28,16 @@ func main() {
 
 	adbFactory := adb.NewRawConnectionFactory()
 	adb := adb.New(sugar, adbFactory)
-	server := server.New(sugar, adb)
+	server := server.New(sugar, adb, config)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+
+	go func() {
+		sig := <-signals
+		sugar.Info("Received ", sig)
+		server.Shutdown()
+	}()
 	
 	server.Start()
 }
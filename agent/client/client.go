@@ -5,8 +5,10 @@ import (
 	"github.com/csnewman/droidmole/agent/client/shell"
 	"github.com/csnewman/droidmole/agent/client/sync"
 	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"time"
 )
 
 // Client represents a connection to a agent.
@@ -58,22 +60,301 @@ type StartEmulatorRequest struct {
 
 	// RootADB requests the ADB connection is rooted. This does not guarantee the connection is rooted.
 	RootADB bool
+
+	// SecondaryDisplays signifies additional displays to attach once the emulator has booted.
+	// Display ids are assigned in order, starting from 1.
+	SecondaryDisplays []SecondaryDisplay
+
+	// DataPartitionSize signifies the size of the data partition in MBs. Set to 0 to use the default of 512.
+	DataPartitionSize uint32
+
+	// SdCardSize signifies the size of the SD card in MBs. Set to 0 to disable the SD card.
+	SdCardSize uint32
+
+	// GPUMode signifies how graphics are rendered. Defaults to the emulator's choice of the AVD, rendering using
+	// SwiftShader, when nil.
+	GPUMode *GPUMode
+
+	// CameraBack signifies the back facing camera.
+	CameraBack Camera
+
+	// CameraFront signifies the front facing camera. The virtual scene is not supported.
+	CameraFront Camera
+
+	// Keyboard signifies whether a hardware keyboard is attached. Defaults to true when nil.
+	Keyboard *bool
+
+	// NetworkProfile signifies the simulated network speed and latency.
+	NetworkProfile NetworkProfile
+
+	// Orientation signifies the initial orientation of the device.
+	Orientation Orientation
+
+	// HeapSize signifies the maximum heap size of an application in MBs. Set to 0 to use the image default.
+	HeapSize uint32
+
+	// HardwareProperties signifies additional "hw.*" properties to write to the AVD config, such as
+	// "hw.gyroscope": "no". Only known properties that are not covered by another field are accepted.
+	HardwareProperties map[string]string
+
+	// ImageOverrides signifies images to boot in place of those provided by the system image.
+	ImageOverrides *ImageOverrides
+
+	// SystemImage signifies the name of the system image to boot, as reported by ListSystemImages. May be left empty
+	// when only a single system image is installed.
+	SystemImage string
+
+	// KeepUserData signifies whether to keep the data partition and SD card of the previous boot, rather than wiping
+	// them.
+	KeepUserData bool
+
+	// Snapshot signifies the name of a snapshot to boot from. Implies KeepUserData. The hardware configuration must match
+	// the configuration the snapshot was saved with, otherwise the emulator cold boots.
+	Snapshot string
+
+	// BootTimeout signifies the maximum time to wait for android to finish booting, after which the emulator is killed.
+	// Rounded down to whole seconds. Leave as 0 to use the default of 300 seconds.
+	BootTimeout time.Duration
+
+	// HealthCheckInterval signifies the interval between health checks of the booted emulator. An emulator that fails
+	// several consecutive checks is killed. Rounded down to whole seconds. Leave as 0 to use the default of 5 seconds.
+	HealthCheckInterval time.Duration
+
+	// RestartPolicy signifies how the emulator is restarted once it exits without being requested to stop. Leave as nil
+	// to never restart.
+	RestartPolicy *RestartPolicy
 }
 
+// RestartMode represents when the emulator is restarted.
+type RestartMode protocol.RestartPolicy_Mode
+
+const (
+	// RestartNever signifies the emulator is never restarted.
+	RestartNever = RestartMode(protocol.RestartPolicy_NEVER)
+
+	// RestartOnFailure signifies the emulator is restarted when it fails.
+	RestartOnFailure = RestartMode(protocol.RestartPolicy_ON_FAILURE)
+
+	// RestartAlways signifies the emulator is restarted whenever it exits, including when the guest shuts down.
+	RestartAlways = RestartMode(protocol.RestartPolicy_ALWAYS)
+)
+
+// RestartPolicy represents how the emulator is restarted once it exits without being requested to stop. Restarts are
+// delayed by a backoff that doubles with each consecutive failure, resetting once the emulator boots.
+type RestartPolicy struct {
+	// Mode signifies when the emulator is restarted.
+	Mode RestartMode
+
+	// MaxRetries signifies the maximum number of consecutive failures to restart after. Leave as 0 for no limit.
+	MaxRetries uint32
+
+	// Backoff signifies the delay before the first restart. Rounded down to whole seconds. Leave as 0 to use the
+	// default of 5 seconds.
+	Backoff time.Duration
+
+	// MaxBackoff signifies the maximum delay between restarts. Rounded down to whole seconds. Leave as 0 to use the
+	// default of 300 seconds.
+	MaxBackoff time.Duration
+}
+
+func (p *RestartPolicy) toProtocol() *protocol.RestartPolicy {
+	if p == nil {
+		return nil
+	}
+
+	return &protocol.RestartPolicy{
+		Mode:       protocol.RestartPolicy_Mode(p.Mode),
+		MaxRetries: p.MaxRetries,
+		Backoff:    uint32(p.Backoff / time.Second),
+		MaxBackoff: uint32(p.MaxBackoff / time.Second),
+	}
+}
+
+func restartPolicyFromProtocol(policy *protocol.RestartPolicy) *RestartPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &RestartPolicy{
+		Mode:       RestartMode(policy.Mode),
+		MaxRetries: policy.MaxRetries,
+		Backoff:    time.Duration(policy.Backoff) * time.Second,
+		MaxBackoff: time.Duration(policy.MaxBackoff) * time.Second,
+	}
+}
+
+// GPUMode represents how graphics are rendered.
+type GPUMode protocol.StartEmulatorRequest_GpuMode
+
+const (
+	// GPUSwiftShaderIndirect signifies software rendering on the host. Works without a host GPU.
+	GPUSwiftShaderIndirect = GPUMode(protocol.StartEmulatorRequest_GPU_SWIFTSHADER_INDIRECT)
+
+	// GPUHost signifies rendering using the host GPU.
+	GPUHost = GPUMode(protocol.StartEmulatorRequest_GPU_HOST)
+
+	// GPUGuest signifies software rendering within the guest.
+	GPUGuest = GPUMode(protocol.StartEmulatorRequest_GPU_GUEST)
+
+	// GPUAngleIndirect signifies software rendering on the host using ANGLE.
+	GPUAngleIndirect = GPUMode(protocol.StartEmulatorRequest_GPU_ANGLE_INDIRECT)
+
+	// GPUAuto signifies the emulator decides.
+	GPUAuto = GPUMode(protocol.StartEmulatorRequest_GPU_AUTO)
+)
+
+func (m *GPUMode) toProtocol() *protocol.StartEmulatorRequest_GpuMode {
+	if m == nil {
+		return nil
+	}
+
+	mode := protocol.StartEmulatorRequest_GpuMode(*m)
+	return &mode
+}
+
+func gpuModeFromProtocol(mode *protocol.StartEmulatorRequest_GpuMode) *GPUMode {
+	if mode == nil {
+		return nil
+	}
+
+	m := GPUMode(*mode)
+	return &m
+}
+
+// Camera represents the source of an emulated camera.
+type Camera protocol.StartEmulatorRequest_Camera
+
+const (
+	// CameraEmulated signifies an emulated camera producing a test pattern.
+	CameraEmulated = Camera(protocol.StartEmulatorRequest_CAMERA_EMULATED)
+
+	// CameraNone signifies no camera.
+	CameraNone = Camera(protocol.StartEmulatorRequest_CAMERA_NONE)
+
+	// CameraVirtualScene signifies a virtual 3D scene. Only supported by the back camera.
+	CameraVirtualScene = Camera(protocol.StartEmulatorRequest_CAMERA_VIRTUAL_SCENE)
+)
+
+// NetworkProfile represents a simulated network speed and latency.
+type NetworkProfile protocol.StartEmulatorRequest_NetworkProfile
+
+const (
+	// NetworkFull signifies no speed limit or added latency.
+	NetworkFull = NetworkProfile(protocol.StartEmulatorRequest_NETWORK_FULL)
+
+	NetworkLTE   = NetworkProfile(protocol.StartEmulatorRequest_NETWORK_LTE)
+	NetworkHSDPA = NetworkProfile(protocol.StartEmulatorRequest_NETWORK_HSDPA)
+	NetworkUMTS  = NetworkProfile(protocol.StartEmulatorRequest_NETWORK_UMTS)
+	NetworkEDGE  = NetworkProfile(protocol.StartEmulatorRequest_NETWORK_EDGE)
+	NetworkGPRS  = NetworkProfile(protocol.StartEmulatorRequest_NETWORK_GPRS)
+	NetworkGSM   = NetworkProfile(protocol.StartEmulatorRequest_NETWORK_GSM)
+)
+
+// Orientation represents the orientation of the device.
+type Orientation protocol.StartEmulatorRequest_Orientation
+
+const (
+	OrientationPortrait  = Orientation(protocol.StartEmulatorRequest_PORTRAIT)
+	OrientationLandscape = Orientation(protocol.StartEmulatorRequest_LANDSCAPE)
+)
+
 // StartEmulator requests the emulator starts. An error will be returned if the emulator is already running.
 func (c *Client) StartEmulator(ctx context.Context, request StartEmulatorRequest) error {
-	_, err := c.client.StartEmulator(ctx, &protocol.StartEmulatorRequest{
-		RamSize:    request.RamSize,
-		CoreCount:  request.CoreCount,
-		LcdDensity: request.LcdDensity,
-		LcdWidth:   request.LcdWidth,
-		LcdHeight:  request.LcdHeight,
-		RootAdb:    request.RootADB,
-	})
+	_, err := c.client.StartEmulator(ctx, request.toProtocol())
+	return err
+}
+
+func (r *StartEmulatorRequest) toProtocol() *protocol.StartEmulatorRequest {
+	var displays []*protocol.SecondaryDisplay
+	for _, display := range r.SecondaryDisplays {
+		displays = append(displays, &protocol.SecondaryDisplay{
+			Width:   display.Width,
+			Height:  display.Height,
+			Density: display.Density,
+			Flags:   uint32(display.Flags),
+		})
+	}
+
+	return &protocol.StartEmulatorRequest{
+		RamSize:             r.RamSize,
+		CoreCount:           r.CoreCount,
+		LcdDensity:          r.LcdDensity,
+		LcdWidth:            r.LcdWidth,
+		LcdHeight:           r.LcdHeight,
+		RootAdb:             r.RootADB,
+		SecondaryDisplays:   displays,
+		DataPartitionSize:   r.DataPartitionSize,
+		SdcardSize:          r.SdCardSize,
+		GpuMode:             r.GPUMode.toProtocol(),
+		CameraBack:          protocol.StartEmulatorRequest_Camera(r.CameraBack),
+		CameraFront:         protocol.StartEmulatorRequest_Camera(r.CameraFront),
+		Keyboard:            r.Keyboard,
+		NetworkProfile:      protocol.StartEmulatorRequest_NetworkProfile(r.NetworkProfile),
+		Orientation:         protocol.StartEmulatorRequest_Orientation(r.Orientation),
+		HeapSize:            r.HeapSize,
+		HardwareProperties:  r.HardwareProperties,
+		ImageOverrides:      r.ImageOverrides.toProtocol(),
+		SystemImage:         r.SystemImage,
+		KeepUserData:        r.KeepUserData,
+		Snapshot:            r.Snapshot,
+		BootTimeout:         uint32(r.BootTimeout / time.Second),
+		HealthCheckInterval: uint32(r.HealthCheckInterval / time.Second),
+		RestartPolicy:       r.RestartPolicy.toProtocol(),
+	}
+}
+
+func startEmulatorRequestFromProtocol(request *protocol.StartEmulatorRequest) *StartEmulatorRequest {
+	if request == nil {
+		return nil
+	}
+
+	var displays []SecondaryDisplay
+	for _, display := range request.SecondaryDisplays {
+		displays = append(displays, SecondaryDisplay{
+			Width:   display.Width,
+			Height:  display.Height,
+			Density: display.Density,
+			Flags:   DisplayFlags(display.Flags),
+		})
+	}
+
+	return &StartEmulatorRequest{
+		RamSize:             request.RamSize,
+		CoreCount:           request.CoreCount,
+		LcdDensity:          request.LcdDensity,
+		LcdWidth:            request.LcdWidth,
+		LcdHeight:           request.LcdHeight,
+		RootADB:             request.RootAdb,
+		SecondaryDisplays:   displays,
+		DataPartitionSize:   request.DataPartitionSize,
+		SdCardSize:          request.SdcardSize,
+		GPUMode:             gpuModeFromProtocol(request.GpuMode),
+		CameraBack:          Camera(request.CameraBack),
+		CameraFront:         Camera(request.CameraFront),
+		Keyboard:            request.Keyboard,
+		NetworkProfile:      NetworkProfile(request.NetworkProfile),
+		Orientation:         Orientation(request.Orientation),
+		HeapSize:            request.HeapSize,
+		HardwareProperties:  request.HardwareProperties,
+		ImageOverrides:      imageOverridesFromProtocol(request.ImageOverrides),
+		SystemImage:         request.SystemImage,
+		KeepUserData:        request.KeepUserData,
+		Snapshot:            request.Snapshot,
+		BootTimeout:         time.Duration(request.BootTimeout) * time.Second,
+		HealthCheckInterval: time.Duration(request.HealthCheckInterval) * time.Second,
+		RestartPolicy:       restartPolicyFromProtocol(request.RestartPolicy),
+	}
+}
+
+// RestartEmulator requests the emulator starts again with the last StartEmulator request. An error will be returned
+// if the emulator is already running or has never been started.
+func (c *Client) RestartEmulator(ctx context.Context) error {
+	_, err := c.client.RestartEmulator(ctx, &empty.Empty{})
 	return err
 }
 
-// StopEmulator requests the emulator exists.
+// StopEmulator requests the emulator exists. A graceful stop is escalated to killing the emulator after 30 seconds.
+// The emulator can be stopped while it is still booting.
 func (c *Client) StopEmulator(ctx context.Context, forceExit bool) error {
 	_, err := c.client.StopEmulator(ctx, &protocol.StopEmulatorRequest{
 		ForceExit: forceExit,
@@ -81,6 +362,53 @@ func (c *Client) StopEmulator(ctx context.Context, forceExit bool) error {
 	return err
 }
 
+// StopEmulatorWithTimeout requests the emulator gracefully exits, killing the emulator if it has not exited within the
+// given timeout. The timeout is rounded down to whole seconds.
+func (c *Client) StopEmulatorWithTimeout(ctx context.Context, timeout time.Duration) error {
+	_, err := c.client.StopEmulator(ctx, &protocol.StopEmulatorRequest{
+		Timeout: uint32(timeout / time.Second),
+	})
+	return err
+}
+
+// VmState represents the run state of the virtual machine.
+type VmState protocol.VmState_RunState
+
+const (
+	VmUnknown       = VmState(protocol.VmState_UNKNOWN)
+	VmRunning       = VmState(protocol.VmState_RUNNING)
+	VmRestoring     = VmState(protocol.VmState_RESTORE_VM)
+	VmPaused        = VmState(protocol.VmState_PAUSED)
+	VmSaving        = VmState(protocol.VmState_SAVE_VM)
+	VmShutdown      = VmState(protocol.VmState_SHUTDOWN)
+	VmInternalError = VmState(protocol.VmState_INTERNAL_ERROR)
+)
+
+// PauseEmulator pauses the emulator, freezing the guest until resumed.
+// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+func (c *Client) PauseEmulator(ctx context.Context) error {
+	_, err := c.client.PauseEmulator(ctx, &empty.Empty{})
+	return err
+}
+
+// ResumeEmulator resumes a paused emulator.
+// Requires that the emulator is in the "paused" state, otherwise an error will be returned.
+func (c *Client) ResumeEmulator(ctx context.Context) error {
+	_, err := c.client.ResumeEmulator(ctx, &empty.Empty{})
+	return err
+}
+
+// GetVmState gets the run state of the virtual machine, as reported by the emulator.
+// Requires that the emulator is in the "running" or "paused" state, otherwise an error will be returned.
+func (c *Client) GetVmState(ctx context.Context) (VmState, error) {
+	state, err := c.client.GetVmState(ctx, &empty.Empty{})
+	if err != nil {
+		return VmUnknown, err
+	}
+
+	return VmState(state.State), nil
+}
+
 // OpenShell opens an ADB shell to the emulator.
 // Requires that the emulator has reached the "running" state, otherwise an error will be returned.
 func (c *Client) OpenShell(ctx context.Context, request shell.Request) (*shell.Shell, error) {
@@ -0,0 +1,168 @@
+package decoder
+
+import (
+	"errors"
+	"github.com/csnewman/droidmole/agent/client"
+	"github.com/csnewman/droidmole/agent/util/vpx"
+	"image"
+)
+
+// Codec represents the codec a decoder accepts.
+type Codec int
+
+const (
+	// VP8 represents the VP8 codec.
+	VP8 Codec = iota
+
+	// VP9 represents the VP9 codec.
+	VP9
+)
+
+// Decoder decodes VP8 or VP9 frames into images using libvpx.
+type Decoder struct {
+	ctx *vpx.CodecCtx
+}
+
+// New creates a decoder for the given codec. The decoder must be closed to free the libvpx resources.
+func New(codec Codec) (*Decoder, error) {
+	var iface *vpx.CodecIface
+
+	switch codec {
+	case VP8:
+		iface = vpx.VP8DecIface()
+	case VP9:
+		iface = vpx.VP9DecIface()
+	default:
+		return nil, errors.New("unknown codec")
+	}
+
+	ctx := vpx.NewCodecCtx()
+
+	err := ctx.DecInit(iface, 0)
+	if err != nil {
+		ctx.Free()
+		return nil, err
+	}
+
+	return &Decoder{
+		ctx: ctx,
+	}, nil
+}
+
+// Decode decodes a single compressed frame. A nil image signifies the frame did not produce an image.
+// The returned image is owned by the caller.
+func (d *Decoder) Decode(data []byte) (image.Image, error) {
+	err := d.ctx.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var iter vpx.CodecIter
+	var img *image.YCbCr
+
+	// Only the most recent image is of interest
+	for frame := d.ctx.GetFrame(&iter); frame != nil; frame = d.ctx.GetFrame(&iter) {
+		img, err = frame.ToYCbCr()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if img == nil {
+		return nil, nil
+	}
+
+	return img, nil
+}
+
+// Close frees the decoder.
+func (d *Decoder) Close() {
+	d.ctx.Destroy()
+	d.ctx.Free()
+}
+
+// Stream converts the frames of a display stream into images.
+type Stream struct {
+	stream    *client.DisplayStream
+	dec       *Decoder
+	keyframed bool
+}
+
+// NewStream wraps a VP8 or RGB888 display stream. The stream must be closed to free the decoder.
+func NewStream(stream *client.DisplayStream) (*Stream, error) {
+	s := &Stream{
+		stream: stream,
+	}
+
+	switch stream.Format() {
+	case client.RGB888:
+	case client.VP8:
+		dec, err := New(VP8)
+		if err != nil {
+			return nil, err
+		}
+
+		s.dec = dec
+	default:
+		return nil, errors.New("unsupported frame format")
+	}
+
+	return s, nil
+}
+
+// Recv blocks until the next image is decoded. Frames received before the first keyframe, and the blank frames sent
+// while the display is off, are skipped.
+func (s *Stream) Recv() (image.Image, error) {
+	for {
+		frame, err := s.stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		// The stream restarts with a keyframe once the display is back on
+		if frame.Width == 0 || frame.Height == 0 {
+			s.keyframed = false
+			continue
+		}
+
+		if s.dec == nil {
+			return rgbToImage(frame), nil
+		}
+
+		if !s.keyframed && !frame.Keyframe {
+			continue
+		}
+
+		s.keyframed = true
+
+		img, err := s.dec.Decode(frame.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		if img != nil {
+			return img, nil
+		}
+	}
+}
+
+// Close frees the decoder. The underlying display stream is not affected.
+func (s *Stream) Close() {
+	if s.dec != nil {
+		s.dec.Close()
+		s.dec = nil
+	}
+}
+
+func rgbToImage(frame *client.Frame) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, int(frame.Width), int(frame.Height)))
+
+	for i := 0; i < int(frame.Width*frame.Height) && 3*i+2 < len(frame.Data); i++ {
+		img.Pix[4*i] = frame.Data[3*i]
+		img.Pix[4*i+1] = frame.Data[3*i+1]
+		img.Pix[4*i+2] = frame.Data[3*i+2]
+		img.Pix[4*i+3] = 0xFF
+	}
+
+	return img
+}
@@ -2,7 +2,10 @@ package client
 
 import (
 	"context"
+	"errors"
 	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/golang/protobuf/ptypes/empty"
+	"sync/atomic"
 )
 
 // FrameFormat represents the format to encode frames with.
@@ -14,6 +17,9 @@ const (
 
 	// VP8 codec. Uses intermediate frames.
 	VP8 = FrameFormat(protocol.StreamDisplayRequest_VP8)
+
+	// H264 codec, baseline profile as an Annex B byte stream. Uses intermediate frames.
+	H264 = FrameFormat(protocol.StreamDisplayRequest_H264)
 )
 
 // A DisplayRequest represents the configuration the display should be streamed.
@@ -29,11 +35,134 @@ type DisplayRequest struct {
 	// KeyframeInterval specifies how often in milliseconds to encode a keyframe.
 	// Set to 0 to only send when required. Not all formats use intermediate frames.
 	KeyframeInterval uint32
+
+	// Display specifies the id of the display to stream. The main display has the id 0.
+	// Secondary displays can only be streamed once the emulator has been started with them, otherwise the stream fails
+	// with a NotFound error.
+	Display uint32
+}
+
+// DisplayFlags represents a combination of android virtual display flags.
+type DisplayFlags uint32
+
+const (
+	// DisplayFlagPublic signifies the display is public and behaves like an externally connected display.
+	DisplayFlagPublic DisplayFlags = 1
+
+	// DisplayFlagPresentation signifies the display is registered as a presentation display.
+	DisplayFlagPresentation DisplayFlags = 2
+
+	// DisplayFlagSecure signifies the display is considered secure.
+	DisplayFlagSecure DisplayFlags = 4
+
+	// DisplayFlagOwnContentOnly signifies the display only shows its own content, rather than mirroring the main
+	// display.
+	DisplayFlagOwnContentOnly DisplayFlags = 8
+
+	// DisplayFlagAutoMirror signifies content may be mirrored onto the display when it has no content.
+	DisplayFlagAutoMirror DisplayFlags = 16
+)
+
+// SecondaryDisplay represents the configuration of an additional display.
+type SecondaryDisplay struct {
+	// Width signifies the width of the display.
+	Width uint32
+
+	// Height signifies the height of the display.
+	Height uint32
+
+	// Density signifies the DPI of the display.
+	Density uint32
+
+	// Flags signifies the android virtual display flags.
+	Flags DisplayFlags
+}
+
+// Display represents a display attached to the emulator.
+type Display struct {
+	// ID signifies the id of the display. The main display has the id 0.
+	ID uint32
+
+	// Width signifies the width of the display.
+	Width uint32
+
+	// Height signifies the height of the display.
+	Height uint32
+
+	// Density signifies the DPI of the display.
+	Density uint32
+
+	// Flags signifies the android virtual display flags.
+	Flags DisplayFlags
+}
+
+// ListDisplays lists the displays attached to the emulator, including the main display.
+// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+func (c *Client) ListDisplays(ctx context.Context) ([]Display, error) {
+	resp, err := c.client.ListDisplays(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var displays []Display
+	for _, display := range resp.Displays {
+		displays = append(displays, Display{
+			ID:      display.Id,
+			Width:   display.Width,
+			Height:  display.Height,
+			Density: display.Density,
+			Flags:   DisplayFlags(display.Flags),
+		})
+	}
+
+	return displays, nil
+}
+
+// ScreenshotFormat represents the format to encode screenshots with.
+type ScreenshotFormat protocol.ScreenshotRequest_ImageFormat
+
+const (
+	// ScreenshotPNG encodes the screenshot as a PNG image.
+	ScreenshotPNG = ScreenshotFormat(protocol.ScreenshotRequest_PNG)
+
+	// ScreenshotRGB888 encodes 3 bytes per pixel.
+	ScreenshotRGB888 = ScreenshotFormat(protocol.ScreenshotRequest_RGB888)
+)
+
+// Screenshot represents a single screenshot.
+type Screenshot struct {
+	// Width specifies the width of the image.
+	Width uint32
+	// Height specifies the height of the image.
+	Height uint32
+	// Data contains the encoded image data.
+	Data []byte
+}
+
+// GetScreenshot captures a single screenshot of the given display.
+// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+func (c *Client) GetScreenshot(ctx context.Context, display uint32, format ScreenshotFormat) (*Screenshot, error) {
+	resp, err := c.client.GetScreenshot(ctx, &protocol.ScreenshotRequest{
+		Display: display,
+		Format:  protocol.ScreenshotRequest_ImageFormat(format),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Screenshot{
+		Width:  resp.Width,
+		Height: resp.Height,
+		Data:   resp.Data,
+	}, nil
 }
 
 // DisplayStream represents a stream of display frames.
 type DisplayStream struct {
+	agent  protocol.AgentControllerClient
 	client protocol.AgentController_StreamDisplayClient
+	format FrameFormat
+	id     atomic.Uint64
 }
 
 // Frame represents a single display frame.
@@ -57,16 +186,24 @@ func (c *Client) StreamDisplay(ctx context.Context, request DisplayRequest) (*Di
 		Format:           protocol.StreamDisplayRequest_FrameFormat(request.Format),
 		MaxFps:           request.MaxFPS,
 		KeyframeInterval: request.KeyframeInterval,
+		Display:          request.Display,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &DisplayStream{
+		agent:  c.client,
 		client: stream,
+		format: request.Format,
 	}, nil
 }
 
+// Format returns the format frames are encoded with.
+func (s *DisplayStream) Format() FrameFormat {
+	return s.format
+}
+
 // Recv blocks until a new frame is generated.
 func (s *DisplayStream) Recv() (*Frame, error) {
 	frame, err := s.client.Recv()
@@ -74,6 +211,8 @@ func (s *DisplayStream) Recv() (*Frame, error) {
 		return nil, err
 	}
 
+	s.id.Store(frame.StreamId)
+
 	return &Frame{
 		Keyframe: frame.Keyframe,
 		Width:    frame.Width,
@@ -81,3 +220,18 @@ func (s *DisplayStream) Recv() (*Frame, error) {
 		Data:     frame.Data,
 	}, nil
 }
+
+// RequestKeyframe requests the next frame produced by the stream is a keyframe.
+// This behaves similarly to an RTP picture loss indication (PLI) and should be used when a viewer loses data or joins a
+// shared stream. At least one frame must have been received before a keyframe can be requested.
+func (s *DisplayStream) RequestKeyframe(ctx context.Context) error {
+	id := s.id.Load()
+	if id == 0 {
+		return errors.New("no frames received")
+	}
+
+	_, err := s.agent.RequestKeyframe(ctx, &protocol.RequestKeyframeRequest{
+		StreamId: id,
+	})
+	return err
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"time"
+)
+
+// LogSeverity represents the severity of an emulator log entry.
+type LogSeverity protocol.EmulatorLogEntry_Severity
+
+const (
+	LogVerbose = LogSeverity(protocol.EmulatorLogEntry_VERBOSE)
+	LogDebug   = LogSeverity(protocol.EmulatorLogEntry_DEBUG)
+	LogInfo    = LogSeverity(protocol.EmulatorLogEntry_INFO)
+	LogWarning = LogSeverity(protocol.EmulatorLogEntry_WARNING)
+	LogError   = LogSeverity(protocol.EmulatorLogEntry_ERROR)
+	LogFatal   = LogSeverity(protocol.EmulatorLogEntry_FATAL)
+)
+
+// LogStream represents the emulator output stream a line was written to.
+type LogStream protocol.EmulatorLogEntry_Stream
+
+const (
+	LogStdout = LogStream(protocol.EmulatorLogEntry_STDOUT)
+	LogStderr = LogStream(protocol.EmulatorLogEntry_STDERR)
+)
+
+// EmulatorLogStream represents a stream of emulator log entries.
+type EmulatorLogStream struct {
+	client protocol.AgentController_StreamEmulatorLogClient
+}
+
+// EmulatorLogEntry represents a single line of emulator output.
+type EmulatorLogEntry struct {
+	// Time represents the time the line was received.
+	Time time.Time
+
+	// Severity represents the severity of the line. Lines without a severity inherit the severity of the previous line.
+	Severity LogSeverity
+
+	// Stream represents the stream the line was written to.
+	Stream LogStream
+
+	// Message represents the line, without the severity prefix.
+	Message string
+}
+
+// StreamEmulatorLog streams the output of the emulator process, skipping entries below the given severity.
+// Recent output is retained, and is streamed first when includeBacklog is set. The stream is persistent between
+// emulator restarts.
+func (c *Client) StreamEmulatorLog(
+	ctx context.Context,
+	minSeverity LogSeverity,
+	includeBacklog bool,
+) (*EmulatorLogStream, error) {
+	stream, err := c.client.StreamEmulatorLog(ctx, &protocol.StreamEmulatorLogRequest{
+		MinSeverity:    protocol.EmulatorLogEntry_Severity(minSeverity),
+		IncludeBacklog: includeBacklog,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmulatorLogStream{
+		client: stream,
+	}, nil
+}
+
+// Recv blocks until a new entry is received.
+func (s *EmulatorLogStream) Recv() (*EmulatorLogEntry, error) {
+	entry, err := s.client.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmulatorLogEntry{
+		Time:     time.UnixMilli(int64(entry.Time)),
+		Severity: LogSeverity(entry.Severity),
+		Stream:   LogStream(entry.Stream),
+		Message:  entry.Message,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/golang/protobuf/ptypes/empty"
+	"io"
+)
+
+const imageChunkSize = 64 * 1024
+
+// SystemImage represents a system image installed in the agent container.
+type SystemImage struct {
+	// Name specifies the name used to select the image when starting the emulator.
+	Name string
+	// ApiLevel specifies the Android API level.
+	ApiLevel uint32
+	// Abi specifies the primary ABI, for example "x86_64".
+	Abi string
+	// Tag specifies the variant of the image, for example "default", "google_apis" or "google_apis_playstore".
+	Tag string
+}
+
+// ListSystemImages lists the system images installed in the agent container.
+func (c *Client) ListSystemImages(ctx context.Context) ([]SystemImage, error) {
+	resp, err := c.client.ListSystemImages(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var images []SystemImage
+	for _, image := range resp.Images {
+		images = append(images, SystemImage{
+			Name:     image.Name,
+			ApiLevel: image.ApiLevel,
+			Abi:      image.Abi,
+			Tag:      image.Tag,
+		})
+	}
+
+	return images, nil
+}
+
+// ImageOverrides represents images to boot in place of those provided by the system image. Each image is either an
+// absolute path within the agent container, or the name of an image uploaded using UploadImage. Leave empty to use the
+// image provided by the system image.
+type ImageOverrides struct {
+	// Kernel signifies the kernel image.
+	Kernel string
+
+	// System signifies the system partition image.
+	System string
+
+	// Vendor signifies the vendor partition image.
+	Vendor string
+
+	// Ramdisk signifies the ramdisk image, which may be compressed using gzip or lz4.
+	Ramdisk string
+
+	// Data signifies the initial data partition image. The image is copied on boot and is never modified.
+	Data string
+}
+
+func (o *ImageOverrides) toProtocol() *protocol.ImageOverrides {
+	if o == nil {
+		return nil
+	}
+
+	optional := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+
+		return &value
+	}
+
+	return &protocol.ImageOverrides{
+		Kernel:  optional(o.Kernel),
+		System:  optional(o.System),
+		Vendor:  optional(o.Vendor),
+		Ramdisk: optional(o.Ramdisk),
+		Data:    optional(o.Data),
+	}
+}
+
+func imageOverridesFromProtocol(overrides *protocol.ImageOverrides) *ImageOverrides {
+	if overrides == nil {
+		return nil
+	}
+
+	return &ImageOverrides{
+		Kernel:  overrides.GetKernel(),
+		System:  overrides.GetSystem(),
+		Vendor:  overrides.GetVendor(),
+		Ramdisk: overrides.GetRamdisk(),
+		Data:    overrides.GetData(),
+	}
+}
+
+// UploadImage uploads an image to the agent under the given name, for use as an image override. An existing image of
+// the same name is replaced once the upload completes. Returns the size of the stored image.
+func (c *Client) UploadImage(ctx context.Context, name string, r io.Reader) (uint64, error) {
+	stream, err := c.client.UploadImage(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = stream.Send(&protocol.UploadImageRequest{
+		Message: &protocol.UploadImageRequest_Start{
+			Start: &protocol.UploadImageStartRequest{
+				Name: name,
+			},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	buffer := make([]byte, imageChunkSize)
+
+	for {
+		n, err := r.Read(buffer)
+		if n > 0 {
+			sendErr := stream.Send(&protocol.UploadImageRequest{
+				Message: &protocol.UploadImageRequest_Data{
+					Data: &protocol.UploadImageDataRequest{
+						Data: buffer[:n],
+					},
+				},
+			})
+			if sendErr != nil {
+				return 0, sendErr
+			}
+		}
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+	}
+
+	image, err := stream.CloseAndRecv()
+	if err != nil {
+		return 0, err
+	}
+
+	return image.Size, nil
+}
+
+// DeleteImage deletes an uploaded image.
+func (c *Client) DeleteImage(ctx context.Context, name string) error {
+	_, err := c.client.DeleteImage(ctx, &protocol.DeleteImageRequest{
+		Name: name,
+	})
+	return err
+}
@@ -28,6 +28,9 @@ type TouchEvent struct {
 	Pressure   uint32
 	TouchMajor int32
 	TouchMinor int32
+
+	// The id of the display to touch. The main display has the id 0.
+	Display uint32
 }
 
 func (e TouchEvent) ToRequest() protocol.InputRequest {
@@ -40,6 +43,41 @@ func (e TouchEvent) ToRequest() protocol.InputRequest {
 				Pressure:   e.Pressure,
 				TouchMajor: e.TouchMajor,
 				TouchMinor: e.TouchMinor,
+				Display:    e.Display,
+			},
+		},
+	}
+}
+
+// KeyEventType represents the action of a key event.
+type KeyEventType protocol.KeyEvent_KeyEventType
+
+const (
+	// KeyDown presses and holds the key.
+	KeyDown = KeyEventType(protocol.KeyEvent_DOWN)
+
+	// KeyUp releases the key.
+	KeyUp = KeyEventType(protocol.KeyEvent_UP)
+
+	// KeyPress presses and immediately releases the key.
+	KeyPress = KeyEventType(protocol.KeyEvent_PRESS)
+)
+
+type KeyEvent struct {
+	// Type signifies whether the key is pressed, released or both.
+	Type KeyEventType
+
+	// Key signifies the key value, following the w3c KeyboardEvent.key standard. For example "a", "Enter" or
+	// "ArrowLeft". The android specific keys "GoBack", "GoHome", "AppSwitch" and "Power" are also supported.
+	Key string
+}
+
+func (e KeyEvent) ToRequest() protocol.InputRequest {
+	return protocol.InputRequest{
+		Event: &protocol.InputRequest_Key{
+			Key: &protocol.KeyEvent{
+				Type: protocol.KeyEvent_KeyEventType(e.Type),
+				Key:  e.Key,
 			},
 		},
 	}
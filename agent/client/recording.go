@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+// RecordingFormat represents the container format of a recording.
+type RecordingFormat protocol.StartRecordingRequest_ContainerFormat
+
+const (
+	// RecordingWebM signifies a WebM container with the VP8 codec.
+	RecordingWebM = RecordingFormat(protocol.StartRecordingRequest_WEBM)
+
+	// RecordingMP4 signifies a MP4 container with the H.264 codec.
+	RecordingMP4 = RecordingFormat(protocol.StartRecordingRequest_MP4)
+)
+
+// RecordingState represents the state of a recording.
+type RecordingState protocol.Recording_RecordingState
+
+const (
+	// RecordingInProgress signifies the recording is in progress.
+	RecordingInProgress = RecordingState(protocol.Recording_RECORDING)
+
+	// RecordingFinished signifies the recording has been stopped and can be downloaded.
+	RecordingFinished = RecordingState(protocol.Recording_FINISHED)
+
+	// RecordingFailed signifies the recording failed. Any frames recorded before the failure can be downloaded.
+	RecordingFailed = RecordingState(protocol.Recording_FAILED)
+)
+
+// A RecordingRequest represents the configuration a display should be recorded with.
+type RecordingRequest struct {
+	// Display specifies the id of the display to record. The main display has the id 0.
+	Display uint32
+
+	// Format specifies the container format.
+	Format RecordingFormat
+
+	// MaxFPS specifies the maximum number of frames to record per second.
+	// Set to 0 to use the default of 30.
+	MaxFPS uint32
+
+	// ShowTouches specifies whether to draw indicators at active touch points.
+	ShowTouches bool
+}
+
+// Recording represents a recording stored on the agent.
+type Recording struct {
+	// ID signifies the id of the recording.
+	ID uint64
+
+	// Display signifies the id of the recorded display.
+	Display uint32
+
+	// Format signifies the container format.
+	Format RecordingFormat
+
+	// State signifies the state of the recording.
+	State RecordingState
+
+	// Size signifies the size of the file in bytes.
+	Size uint64
+
+	// Duration signifies the duration of the recording in milliseconds.
+	Duration uint32
+
+	// Error stores the error message associated with the failed state.
+	Error *string
+}
+
+func convertRecording(recording *protocol.Recording) *Recording {
+	return &Recording{
+		ID:       recording.Id,
+		Display:  recording.Display,
+		Format:   RecordingFormat(recording.Format),
+		State:    RecordingState(recording.State),
+		Size:     recording.Size,
+		Duration: recording.Duration,
+		Error:    recording.Error,
+	}
+}
+
+// StartRecording starts recording a display to a file stored on the agent.
+// The recording is persistent between emulator restarts, with periods where the display is off skipped.
+func (c *Client) StartRecording(ctx context.Context, request RecordingRequest) (*Recording, error) {
+	resp, err := c.client.StartRecording(ctx, &protocol.StartRecordingRequest{
+		Display:     request.Display,
+		Format:      protocol.StartRecordingRequest_ContainerFormat(request.Format),
+		MaxFps:      request.MaxFPS,
+		ShowTouches: request.ShowTouches,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertRecording(resp), nil
+}
+
+// StopRecording stops a recording and finalises the file.
+func (c *Client) StopRecording(ctx context.Context, id uint64) (*Recording, error) {
+	resp, err := c.client.StopRecording(ctx, &protocol.StopRecordingRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertRecording(resp), nil
+}
+
+// ListRecordings lists the recordings stored on the agent.
+func (c *Client) ListRecordings(ctx context.Context) ([]*Recording, error) {
+	resp, err := c.client.ListRecordings(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var recordings []*Recording
+	for _, recording := range resp.Recordings {
+		recordings = append(recordings, convertRecording(recording))
+	}
+
+	return recordings, nil
+}
+
+// DeleteRecording deletes a recording, stopping it first if required.
+func (c *Client) DeleteRecording(ctx context.Context, id uint64) error {
+	_, err := c.client.DeleteRecording(ctx, &protocol.DeleteRecordingRequest{
+		Id: id,
+	})
+	return err
+}
+
+// RecordingDownload represents a recording download stream.
+type RecordingDownload struct {
+	client   protocol.AgentController_DownloadRecordingClient
+	complete bool
+}
+
+// DownloadRecording starts downloading a finished recording.
+func (c *Client) DownloadRecording(ctx context.Context, id uint64) (*RecordingDownload, error) {
+	stream, err := c.client.DownloadRecording(ctx, &protocol.DownloadRecordingRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecordingDownload{
+		client: stream,
+	}, nil
+}
+
+// Recv blocks until a new fragment is received.
+// A (nil, nil) response signifies the download has completed.
+func (s *RecordingDownload) Recv() ([]byte, error) {
+	if s.complete {
+		return nil, nil
+	}
+
+	resp, err := s.client.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Last {
+		s.complete = true
+	}
+
+	return resp.Data, nil
+}
+
+// Complete returns whether the entire recording has been returned.
+func (s *RecordingDownload) Complete() bool {
+	return s.complete
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/golang/protobuf/ptypes/empty"
+	"io"
+	"time"
+)
+
+const snapshotChunkSize = 64 * 1024
+
+// Snapshot represents a stored snapshot of the emulator.
+type Snapshot struct {
+	// Name signifies the name of the snapshot.
+	Name string
+
+	// Size signifies the size of the snapshot on disk in bytes.
+	Size uint64
+
+	// CreatedAt signifies when the snapshot was saved.
+	CreatedAt time.Time
+}
+
+func convertSnapshot(snapshot *protocol.Snapshot) *Snapshot {
+	return &Snapshot{
+		Name:      snapshot.Name,
+		Size:      snapshot.Size,
+		CreatedAt: time.Unix(snapshot.CreatedAt, 0),
+	}
+}
+
+// SaveSnapshot saves the state of the running emulator as a named snapshot, replacing any existing snapshot of the
+// same name. Names may contain letters, digits, '_', '.' and '-'.
+// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+func (c *Client) SaveSnapshot(ctx context.Context, name string) (*Snapshot, error) {
+	resp, err := c.client.SaveSnapshot(ctx, &protocol.SaveSnapshotRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSnapshot(resp), nil
+}
+
+// LoadSnapshot restores the running emulator to a snapshot.
+// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+func (c *Client) LoadSnapshot(ctx context.Context, name string) error {
+	_, err := c.client.LoadSnapshot(ctx, &protocol.LoadSnapshotRequest{
+		Name: name,
+	})
+	return err
+}
+
+// ListSnapshots lists the stored snapshots.
+func (c *Client) ListSnapshots(ctx context.Context) ([]*Snapshot, error) {
+	resp, err := c.client.ListSnapshots(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*Snapshot
+	for _, snapshot := range resp.Snapshots {
+		snapshots = append(snapshots, convertSnapshot(snapshot))
+	}
+
+	return snapshots, nil
+}
+
+// DeleteSnapshot deletes a stored snapshot. An error will be returned if the emulator is starting.
+func (c *Client) DeleteSnapshot(ctx context.Context, name string) error {
+	_, err := c.client.DeleteSnapshot(ctx, &protocol.DeleteSnapshotRequest{
+		Name: name,
+	})
+	return err
+}
+
+// ExportSnapshot writes a snapshot, along with the AVD data it depends on, to w as a gzip compressed tar archive.
+// Requires that the emulator is stopped, otherwise an error will be returned.
+func (c *Client) ExportSnapshot(ctx context.Context, name string, w io.Writer) error {
+	stream, err := c.client.ExportSnapshot(ctx, &protocol.ExportSnapshotRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		_, err = w.Write(resp.Data)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ImportSnapshot imports a snapshot archive produced by ExportSnapshot, replacing the AVD data and all existing
+// snapshots. The archive must have been exported by an agent with the same emulator version and system image build.
+// Requires that the emulator is stopped, otherwise an error will be returned.
+func (c *Client) ImportSnapshot(ctx context.Context, r io.Reader) (*Snapshot, error) {
+	stream, err := c.client.ImportSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := make([]byte, snapshotChunkSize)
+
+	for {
+		n, err := r.Read(buffer)
+		if n > 0 {
+			sendErr := stream.Send(&protocol.ImportSnapshotRequest{
+				Data: buffer[:n],
+			})
+			if sendErr == io.EOF {
+				// The server has failed, the cause is returned when closing the stream
+				break
+			} else if sendErr != nil {
+				return nil, sendErr
+			}
+		}
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSnapshot(resp), nil
+}
@@ -2,8 +2,20 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/csnewman/droidmole/agent/protocol"
-	"github.com/golang/protobuf/ptypes/empty"
+	"time"
+)
+
+var (
+	// ErrEmulatorFailed signifies the emulator failed while waiting for a state.
+	ErrEmulatorFailed = errors.New("emulator failed")
+
+	// ErrEmulatorStopped signifies the emulator stopped while waiting for a state.
+	ErrEmulatorStopped = errors.New("emulator stopped")
+
+	errStateReached = errors.New("state reached")
 )
 
 // EmulatorState represents the state of android emulator.
@@ -21,8 +33,46 @@ const (
 
 	// EmulatorRunning signifies the emulator is running and adb has connected.
 	EmulatorRunning = EmulatorState(protocol.AgentState_RUNNING)
+
+	// EmulatorPaused signifies the emulator has been paused and is not consuming any CPU time.
+	EmulatorPaused = EmulatorState(protocol.AgentState_PAUSED)
+
+	// EmulatorBooting signifies adb has connected and the emulator is waiting for android to finish booting.
+	EmulatorBooting = EmulatorState(protocol.AgentState_BOOTING)
+
+	// EmulatorStopping signifies the emulator has been requested to stop and is shutting down.
+	EmulatorStopping = EmulatorState(protocol.AgentState_STOPPING)
+)
+
+// BootStage represents a stage of the android boot process.
+type BootStage protocol.AgentState_BootStage
+
+const (
+	// BootNotBooted signifies no boot progress has been observed.
+	BootNotBooted = BootStage(protocol.AgentState_NOT_BOOTED)
+
+	// BootKernel signifies the kernel is booting.
+	BootKernel = BootStage(protocol.AgentState_KERNEL)
+
+	// BootInit signifies the init process has started.
+	BootInit = BootStage(protocol.AgentState_INIT)
+
+	// BootZygote signifies the zygote process has started.
+	BootZygote = BootStage(protocol.AgentState_ZYGOTE)
+
+	// BootSystemServer signifies the system server has started.
+	BootSystemServer = BootStage(protocol.AgentState_SYSTEM_SERVER)
+
+	// BootCompleted signifies android has finished booting and the package manager is available.
+	BootCompleted = BootStage(protocol.AgentState_BOOT_COMPLETED)
 )
 
+// BootProgress represents the time a boot stage was reached.
+type BootProgress struct {
+	Stage BootStage
+	Time  time.Time
+}
+
 // StateStream represents a stream of agent states.
 type StateStream struct {
 	client protocol.AgentController_StreamStateClient
@@ -35,13 +85,118 @@ type State struct {
 
 	// EmulatorError stores the error message associated with the error state.
 	EmulatorError *string
+
+	// BootStage stores the furthest boot stage reached by the current emulator.
+	BootStage BootStage
+
+	// BootProgress stores the time each boot stage was reached, in order.
+	BootProgress []BootProgress
+
+	// RestartCount stores the number of times the emulator has been restarted by the restart policy since the last
+	// StartEmulator request.
+	RestartCount uint32
+
+	// LastFailure stores the reason the emulator last failed, which may have caused a restart.
+	LastFailure *string
+
+	// NextRestart stores the time a restart is scheduled for, if any.
+	NextRestart *time.Time
+
+	// Config stores the configuration the current emulator was started with.
+	Config *StartEmulatorRequest
+
+	// EmulatorVersion stores the version of the current emulator, once connected.
+	EmulatorVersion *string
+
+	// SystemImage stores the system image the current emulator was started with.
+	SystemImage *SystemImage
+
+	// StartedAt stores the time the current emulator was started.
+	StartedAt *time.Time
+
+	// BootedAt stores the time the current emulator finished booting.
+	BootedAt *time.Time
+
+	// Uptime stores the time since the current emulator was started. Zero when the emulator is not running.
+	Uptime time.Duration
+
+	// AdbSerial stores the adb serial of the current emulator, once adb has connected.
+	AdbSerial *string
+
+	// AdbRoot stores whether adbd is running as root on the current emulator, once booted.
+	AdbRoot bool
+
+	// Sequence stores a number incremented whenever the agent state changes. Changes to the uptime alone are not
+	// counted.
+	Sequence uint64
+
+	// PreviousState stores the emulator state before the most recent change of emulator state.
+	PreviousState *EmulatorState
+
+	// FatalEvents stores the fatal events detected in the output of the current emulator, in order.
+	FatalEvents []FatalEvent
+}
+
+// FatalEventType represents a type of fatal guest failure.
+type FatalEventType protocol.FatalEvent_Type
+
+const (
+	// FatalKernelPanic signifies the kernel panicked.
+	FatalKernelPanic = FatalEventType(protocol.FatalEvent_KERNEL_PANIC)
+
+	// FatalInitFailure signifies the init process failed.
+	FatalInitFailure = FatalEventType(protocol.FatalEvent_INIT_FAILURE)
+
+	// FatalWatchdogReset signifies a watchdog detected a lockup or reset the guest.
+	FatalWatchdogReset = FatalEventType(protocol.FatalEvent_WATCHDOG_RESET)
+
+	// FatalZygoteCrashLoop signifies zygote repeatedly crashed.
+	FatalZygoteCrashLoop = FatalEventType(protocol.FatalEvent_ZYGOTE_CRASH_LOOP)
+)
+
+// FatalEvent represents a fatal failure of the guest, detected in the syslog or emulator output.
+type FatalEvent struct {
+	// Type stores the type of failure.
+	Type FatalEventType
+
+	// Time stores the time the event was detected.
+	Time time.Time
+
+	// Message stores the line the event was detected from.
+	Message string
+
+	// Excerpt stores the lines leading up to and including the detected line.
+	Excerpt []string
+}
+
+func optionalTime(millis *uint64) *time.Time {
+	if millis == nil {
+		return nil
+	}
+
+	t := time.UnixMilli(int64(*millis))
+	return &t
 }
 
 // StreamState streams the state of the agent process.
 // An initial value will be immediately produced with the current agent state. Subsequent values may indicate a change
 // in the agent state, however this is not guaranteed and the same state can be delivered multiple times.
 func (c *Client) StreamState(ctx context.Context) (*StateStream, error) {
-	stream, err := c.client.StreamState(ctx, &empty.Empty{})
+	return c.streamState(ctx, false)
+}
+
+// StreamStateTransitions streams changes to the state of the agent process.
+// An initial value will be immediately produced with the current agent state. Subsequent values are only produced when
+// the agent state changes. Intermediate states may be skipped if states are received slowly, which can be detected
+// using State.Sequence.
+func (c *Client) StreamStateTransitions(ctx context.Context) (*StateStream, error) {
+	return c.streamState(ctx, true)
+}
+
+func (c *Client) streamState(ctx context.Context, transitionsOnly bool) (*StateStream, error) {
+	stream, err := c.client.StreamState(ctx, &protocol.StreamStateRequest{
+		TransitionsOnly: transitionsOnly,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +206,70 @@ func (c *Client) StreamState(ctx context.Context) (*StateStream, error) {
 	}, nil
 }
 
+// WatchState calls the handler with the current agent state, and then with each change to the agent state. Blocks
+// until the context is cancelled, the stream fails or the handler returns an error, which is returned.
+func (c *Client) WatchState(ctx context.Context, handler func(state *State) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.StreamStateTransitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		state, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return err
+		}
+
+		err = handler(state)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// WaitForState blocks until the emulator is in one of the given states, returning the matching state. An error is
+// returned if the emulator fails or stops while waiting and no restart is scheduled, so the emulator must have been
+// started before waiting for it to run.
+func (c *Client) WaitForState(ctx context.Context, states ...EmulatorState) (*State, error) {
+	var result *State
+
+	err := c.WatchState(ctx, func(state *State) error {
+		for _, s := range states {
+			if state.EmulatorState == s {
+				result = state
+				return errStateReached
+			}
+		}
+
+		if state.EmulatorState == EmulatorError && state.NextRestart == nil {
+			msg := "unknown error"
+			if state.EmulatorError != nil {
+				msg = *state.EmulatorError
+			}
+
+			return fmt.Errorf("%w: %s", ErrEmulatorFailed, msg)
+		}
+
+		if state.EmulatorState == EmulatorOff && state.NextRestart == nil {
+			return ErrEmulatorStopped
+		}
+
+		return nil
+	})
+	if errors.Is(err, errStateReached) {
+		return result, nil
+	}
+
+	return nil, err
+}
+
 // Recv blocks until a new state is received.
 func (s *StateStream) Recv() (*State, error) {
 	state, err := s.client.Recv()
@@ -58,8 +277,52 @@ func (s *StateStream) Recv() (*State, error) {
 		return nil, err
 	}
 
+	progress := make([]BootProgress, len(state.BootProgress))
+	for i, p := range state.BootProgress {
+		progress[i] = BootProgress{
+			Stage: BootStage(p.Stage),
+			Time:  time.UnixMilli(int64(p.Time)),
+		}
+	}
+
+	events := make([]FatalEvent, len(state.FatalEvents))
+	for i, event := range state.FatalEvents {
+		events[i] = FatalEvent{
+			Type:    FatalEventType(event.Type),
+			Time:    time.UnixMilli(int64(event.Time)),
+			Message: event.Message,
+			Excerpt: event.Excerpt,
+		}
+	}
+
+	var image *SystemImage
+	if state.SystemImage != nil {
+		image = &SystemImage{
+			Name:     state.SystemImage.Name,
+			ApiLevel: state.SystemImage.ApiLevel,
+			Abi:      state.SystemImage.Abi,
+			Tag:      state.SystemImage.Tag,
+		}
+	}
+
 	return &State{
-		EmulatorState: EmulatorState(state.EmulatorState),
-		EmulatorError: state.EmulatorError,
+		EmulatorState:   EmulatorState(state.EmulatorState),
+		EmulatorError:   state.EmulatorError,
+		BootStage:       BootStage(state.BootStage),
+		BootProgress:    progress,
+		RestartCount:    state.RestartCount,
+		LastFailure:     state.LastFailure,
+		NextRestart:     optionalTime(state.NextRestart),
+		Config:          startEmulatorRequestFromProtocol(state.Config),
+		EmulatorVersion: state.EmulatorVersion,
+		SystemImage:     image,
+		StartedAt:       optionalTime(state.StartedAt),
+		BootedAt:        optionalTime(state.BootedAt),
+		Uptime:          time.Duration(state.Uptime) * time.Millisecond,
+		AdbSerial:       state.AdbSerial,
+		AdbRoot:         state.AdbRoot,
+		Sequence:        state.Sequence,
+		PreviousState:   (*EmulatorState)(state.PreviousState),
+		FatalEvents:     events,
 	}, nil
 }
@@ -3,7 +3,7 @@ package client
 import (
 	"context"
 	"github.com/csnewman/droidmole/agent/protocol"
-	"github.com/golang/protobuf/ptypes/empty"
+	"time"
 )
 
 // SysLogStream represents a stream of syslog entries.
@@ -11,17 +11,64 @@ type SysLogStream struct {
 	client protocol.AgentController_StreamSysLogClient
 }
 
+// SysLogLevel represents a kernel log level.
+type SysLogLevel protocol.SysLogEntry_Level
+
+const (
+	SysLogEmergency = SysLogLevel(protocol.SysLogEntry_EMERGENCY)
+	SysLogAlert     = SysLogLevel(protocol.SysLogEntry_ALERT)
+	SysLogCritical  = SysLogLevel(protocol.SysLogEntry_CRITICAL)
+	SysLogError     = SysLogLevel(protocol.SysLogEntry_ERROR)
+	SysLogWarning   = SysLogLevel(protocol.SysLogEntry_WARNING)
+	SysLogNotice    = SysLogLevel(protocol.SysLogEntry_NOTICE)
+	SysLogInfo      = SysLogLevel(protocol.SysLogEntry_INFO)
+	SysLogDebug     = SysLogLevel(protocol.SysLogEntry_DEBUG)
+)
+
 // SysLogEntry represents a syslog entry.
 type SysLogEntry struct {
 	// Line represents the raw line.
 	Line string
+
+	// Time represents the time the line was received by the agent.
+	Time time.Time
+
+	// KernelTime represents the time since the kernel booted, if the line has a "[ seconds]" prefix.
+	KernelTime *time.Duration
+
+	// Level represents the log level, if the line has a "<level>" prefix.
+	Level *SysLogLevel
+
+	// Message represents the line without the level and time prefixes.
+	Message string
+
+	// Dropped represents the number of entries dropped from this stream so far, as the client fell behind.
+	Dropped uint64
+}
+
+// SysLogOptions represents the options of a syslog stream.
+type SysLogOptions struct {
+	// Replay signifies whether to first stream the recent entries retained by the agent.
+	Replay bool
+
+	// Filter signifies a regular expression (RE2 syntax) lines must match to be streamed. Leave empty to stream all
+	// lines.
+	Filter string
 }
 
 // StreamSysLog streams the system log (kernel messages).
 // Previous messages are not returned. This stream can and should be started before the emulator is started to ensure no
 // messages are missed. The stream will is persistent between emulator restarts.
 func (c *Client) StreamSysLog(ctx context.Context) (*SysLogStream, error) {
-	stream, err := c.client.StreamSysLog(ctx, &empty.Empty{})
+	return c.StreamSysLogWithOptions(ctx, SysLogOptions{})
+}
+
+// StreamSysLogWithOptions streams the system log (kernel messages) with the given options.
+func (c *Client) StreamSysLogWithOptions(ctx context.Context, options SysLogOptions) (*SysLogStream, error) {
+	stream, err := c.client.StreamSysLog(ctx, &protocol.StreamSysLogRequest{
+		Replay: options.Replay,
+		Filter: options.Filter,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +85,22 @@ func (s *SysLogStream) Recv() (*SysLogEntry, error) {
 		return nil, err
 	}
 
-	return &SysLogEntry{
-		Line: entry.Line,
-	}, nil
+	result := &SysLogEntry{
+		Line:    entry.Line,
+		Time:    time.UnixMilli(int64(entry.Time)),
+		Message: entry.Message,
+		Dropped: entry.Dropped,
+	}
+
+	if entry.KernelTime != nil {
+		kernelTime := time.Duration(*entry.KernelTime * float64(time.Second))
+		result.KernelTime = &kernelTime
+	}
+
+	if entry.Level != nil {
+		level := SysLogLevel(*entry.Level)
+		result.Level = &level
+	}
+
+	return result, nil
 }
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"errors"
+	"github.com/csnewman/droidmole/agent/util/ivf"
+	"github.com/csnewman/droidmole/agent/util/webm"
+	"io"
+	"time"
+)
+
+// StreamContainer represents the container format to save a display stream with.
+type StreamContainer int
+
+const (
+	// ContainerIVF signifies an IVF file.
+	ContainerIVF StreamContainer = iota
+
+	// ContainerWebM signifies a WebM file.
+	ContainerWebM
+)
+
+type streamMuxer interface {
+	WriteFrame(data []byte, keyframe bool, timestamp time.Duration) error
+	Close() error
+}
+
+// StreamWriter saves the frames of a VP8 display stream to a file.
+// Frames are timestamped when written, so should be written as they are received.
+type StreamWriter struct {
+	w         io.Writer
+	container StreamContainer
+	muxer     streamMuxer
+	start     time.Time
+}
+
+// NewStreamWriter creates a writer for frames of the given format. Only the VP8 format can be saved.
+// If w implements io.Seeker, the file headers are completed when the writer is closed.
+func NewStreamWriter(w io.Writer, container StreamContainer, format FrameFormat) (*StreamWriter, error) {
+	if format != VP8 {
+		return nil, errors.New("unsupported frame format")
+	}
+
+	if container != ContainerIVF && container != ContainerWebM {
+		return nil, errors.New("unknown container")
+	}
+
+	return &StreamWriter{
+		w:         w,
+		container: container,
+	}, nil
+}
+
+// WriteFrame writes a single frame. Frames received before the first keyframe are discarded, with the file header
+// using the size of the first keyframe. The blank frames sent while the display is off are discarded.
+func (s *StreamWriter) WriteFrame(frame *Frame) error {
+	now := time.Now()
+
+	if frame.Width == 0 || frame.Height == 0 || len(frame.Data) == 0 {
+		return nil
+	}
+
+	if s.muxer == nil {
+		if !frame.Keyframe {
+			return nil
+		}
+
+		var err error
+		if s.container == ContainerWebM {
+			s.muxer, err = webm.NewWriter(s.w, webm.CodecVP8, frame.Width, frame.Height)
+		} else {
+			s.muxer, err = ivf.NewWriter(s.w, ivf.CodecVP8, frame.Width, frame.Height)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		s.start = now
+	}
+
+	return s.muxer.WriteFrame(frame.Data, frame.Keyframe, now.Sub(s.start))
+}
+
+// Close completes the file. The underlying writer is not closed.
+func (s *StreamWriter) Close() error {
+	if s.muxer == nil {
+		return nil
+	}
+
+	return s.muxer.Close()
+}
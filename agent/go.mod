@@ -5,6 +5,7 @@ go 1.20
 require (
 	github.com/golang/protobuf v1.5.2
 	github.com/matryer/is v1.4.1
+	github.com/pierrec/lz4/v4 v4.1.17
 	github.com/tmthrgd/go-shm v0.0.0-20230106080200-1ec4c2ba35cf
 	go.uber.org/zap v1.24.0
 	golang.org/x/sys v0.4.0
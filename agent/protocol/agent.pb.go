@@ -32,6 +32,12 @@ const (
 	AgentState_STARTING AgentState_EmulatorState = 2
 	// The emulator is running and adb has connected.
 	AgentState_RUNNING AgentState_EmulatorState = 3
+	// The emulator has been paused and is not consuming any CPU time.
+	AgentState_PAUSED AgentState_EmulatorState = 4
+	// adb has connected and the emulator is waiting for android to finish booting.
+	AgentState_BOOTING AgentState_EmulatorState = 5
+	// The emulator has been requested to stop and is shutting down.
+	AgentState_STOPPING AgentState_EmulatorState = 6
 )
 
 // Enum value maps for AgentState_EmulatorState.
@@ -41,12 +47,18 @@ var (
 		1: "OFF",
 		2: "STARTING",
 		3: "RUNNING",
+		4: "PAUSED",
+		5: "BOOTING",
+		6: "STOPPING",
 	}
 	AgentState_EmulatorState_value = map[string]int32{
 		"ERROR":    0,
 		"OFF":      1,
 		"STARTING": 2,
 		"RUNNING":  3,
+		"PAUSED":   4,
+		"BOOTING":  5,
+		"STOPPING": 6,
 	}
 )
 
@@ -77,6 +89,514 @@ func (AgentState_EmulatorState) EnumDescriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type AgentState_BootStage int32
+
+const (
+	// No boot progress has been observed.
+	AgentState_NOT_BOOTED AgentState_BootStage = 0
+	// The kernel is booting.
+	AgentState_KERNEL AgentState_BootStage = 1
+	// The init process has started.
+	AgentState_INIT AgentState_BootStage = 2
+	// The zygote process has started.
+	AgentState_ZYGOTE AgentState_BootStage = 3
+	// The system server has started.
+	AgentState_SYSTEM_SERVER AgentState_BootStage = 4
+	// Android has finished booting and the package manager is available.
+	AgentState_BOOT_COMPLETED AgentState_BootStage = 5
+)
+
+// Enum value maps for AgentState_BootStage.
+var (
+	AgentState_BootStage_name = map[int32]string{
+		0: "NOT_BOOTED",
+		1: "KERNEL",
+		2: "INIT",
+		3: "ZYGOTE",
+		4: "SYSTEM_SERVER",
+		5: "BOOT_COMPLETED",
+	}
+	AgentState_BootStage_value = map[string]int32{
+		"NOT_BOOTED":     0,
+		"KERNEL":         1,
+		"INIT":           2,
+		"ZYGOTE":         3,
+		"SYSTEM_SERVER":  4,
+		"BOOT_COMPLETED": 5,
+	}
+)
+
+func (x AgentState_BootStage) Enum() *AgentState_BootStage {
+	p := new(AgentState_BootStage)
+	*p = x
+	return p
+}
+
+func (x AgentState_BootStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgentState_BootStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[1].Descriptor()
+}
+
+func (AgentState_BootStage) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[1]
+}
+
+func (x AgentState_BootStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgentState_BootStage.Descriptor instead.
+func (AgentState_BootStage) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type FatalEvent_Type int32
+
+const (
+	// The kernel panicked.
+	FatalEvent_KERNEL_PANIC FatalEvent_Type = 0
+	// The init process failed.
+	FatalEvent_INIT_FAILURE FatalEvent_Type = 1
+	// A watchdog detected a lockup or reset the guest.
+	FatalEvent_WATCHDOG_RESET FatalEvent_Type = 2
+	// Zygote repeatedly crashed.
+	FatalEvent_ZYGOTE_CRASH_LOOP FatalEvent_Type = 3
+)
+
+// Enum value maps for FatalEvent_Type.
+var (
+	FatalEvent_Type_name = map[int32]string{
+		0: "KERNEL_PANIC",
+		1: "INIT_FAILURE",
+		2: "WATCHDOG_RESET",
+		3: "ZYGOTE_CRASH_LOOP",
+	}
+	FatalEvent_Type_value = map[string]int32{
+		"KERNEL_PANIC":      0,
+		"INIT_FAILURE":      1,
+		"WATCHDOG_RESET":    2,
+		"ZYGOTE_CRASH_LOOP": 3,
+	}
+)
+
+func (x FatalEvent_Type) Enum() *FatalEvent_Type {
+	p := new(FatalEvent_Type)
+	*p = x
+	return p
+}
+
+func (x FatalEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FatalEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[2].Descriptor()
+}
+
+func (FatalEvent_Type) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[2]
+}
+
+func (x FatalEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FatalEvent_Type.Descriptor instead.
+func (FatalEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type StartEmulatorRequest_GpuMode int32
+
+const (
+	// Software rendering on the host. Works without a host GPU.
+	StartEmulatorRequest_GPU_SWIFTSHADER_INDIRECT StartEmulatorRequest_GpuMode = 0
+	// Rendering using the host GPU.
+	StartEmulatorRequest_GPU_HOST StartEmulatorRequest_GpuMode = 1
+	// Software rendering within the guest.
+	StartEmulatorRequest_GPU_GUEST StartEmulatorRequest_GpuMode = 2
+	// Software rendering on the host using ANGLE.
+	StartEmulatorRequest_GPU_ANGLE_INDIRECT StartEmulatorRequest_GpuMode = 3
+	// Let the emulator decide.
+	StartEmulatorRequest_GPU_AUTO StartEmulatorRequest_GpuMode = 4
+)
+
+// Enum value maps for StartEmulatorRequest_GpuMode.
+var (
+	StartEmulatorRequest_GpuMode_name = map[int32]string{
+		0: "GPU_SWIFTSHADER_INDIRECT",
+		1: "GPU_HOST",
+		2: "GPU_GUEST",
+		3: "GPU_ANGLE_INDIRECT",
+		4: "GPU_AUTO",
+	}
+	StartEmulatorRequest_GpuMode_value = map[string]int32{
+		"GPU_SWIFTSHADER_INDIRECT": 0,
+		"GPU_HOST":                 1,
+		"GPU_GUEST":                2,
+		"GPU_ANGLE_INDIRECT":       3,
+		"GPU_AUTO":                 4,
+	}
+)
+
+func (x StartEmulatorRequest_GpuMode) Enum() *StartEmulatorRequest_GpuMode {
+	p := new(StartEmulatorRequest_GpuMode)
+	*p = x
+	return p
+}
+
+func (x StartEmulatorRequest_GpuMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StartEmulatorRequest_GpuMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[3].Descriptor()
+}
+
+func (StartEmulatorRequest_GpuMode) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[3]
+}
+
+func (x StartEmulatorRequest_GpuMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StartEmulatorRequest_GpuMode.Descriptor instead.
+func (StartEmulatorRequest_GpuMode) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type StartEmulatorRequest_Camera int32
+
+const (
+	// An emulated camera producing a test pattern.
+	StartEmulatorRequest_CAMERA_EMULATED StartEmulatorRequest_Camera = 0
+	// No camera.
+	StartEmulatorRequest_CAMERA_NONE StartEmulatorRequest_Camera = 1
+	// A virtual 3D scene. Only supported by the back camera.
+	StartEmulatorRequest_CAMERA_VIRTUAL_SCENE StartEmulatorRequest_Camera = 2
+)
+
+// Enum value maps for StartEmulatorRequest_Camera.
+var (
+	StartEmulatorRequest_Camera_name = map[int32]string{
+		0: "CAMERA_EMULATED",
+		1: "CAMERA_NONE",
+		2: "CAMERA_VIRTUAL_SCENE",
+	}
+	StartEmulatorRequest_Camera_value = map[string]int32{
+		"CAMERA_EMULATED":      0,
+		"CAMERA_NONE":          1,
+		"CAMERA_VIRTUAL_SCENE": 2,
+	}
+)
+
+func (x StartEmulatorRequest_Camera) Enum() *StartEmulatorRequest_Camera {
+	p := new(StartEmulatorRequest_Camera)
+	*p = x
+	return p
+}
+
+func (x StartEmulatorRequest_Camera) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StartEmulatorRequest_Camera) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[4].Descriptor()
+}
+
+func (StartEmulatorRequest_Camera) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[4]
+}
+
+func (x StartEmulatorRequest_Camera) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StartEmulatorRequest_Camera.Descriptor instead.
+func (StartEmulatorRequest_Camera) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{4, 1}
+}
+
+type StartEmulatorRequest_NetworkProfile int32
+
+const (
+	// No speed limit or added latency.
+	StartEmulatorRequest_NETWORK_FULL  StartEmulatorRequest_NetworkProfile = 0
+	StartEmulatorRequest_NETWORK_LTE   StartEmulatorRequest_NetworkProfile = 1
+	StartEmulatorRequest_NETWORK_HSDPA StartEmulatorRequest_NetworkProfile = 2
+	StartEmulatorRequest_NETWORK_UMTS  StartEmulatorRequest_NetworkProfile = 3
+	StartEmulatorRequest_NETWORK_EDGE  StartEmulatorRequest_NetworkProfile = 4
+	StartEmulatorRequest_NETWORK_GPRS  StartEmulatorRequest_NetworkProfile = 5
+	StartEmulatorRequest_NETWORK_GSM   StartEmulatorRequest_NetworkProfile = 6
+)
+
+// Enum value maps for StartEmulatorRequest_NetworkProfile.
+var (
+	StartEmulatorRequest_NetworkProfile_name = map[int32]string{
+		0: "NETWORK_FULL",
+		1: "NETWORK_LTE",
+		2: "NETWORK_HSDPA",
+		3: "NETWORK_UMTS",
+		4: "NETWORK_EDGE",
+		5: "NETWORK_GPRS",
+		6: "NETWORK_GSM",
+	}
+	StartEmulatorRequest_NetworkProfile_value = map[string]int32{
+		"NETWORK_FULL":  0,
+		"NETWORK_LTE":   1,
+		"NETWORK_HSDPA": 2,
+		"NETWORK_UMTS":  3,
+		"NETWORK_EDGE":  4,
+		"NETWORK_GPRS":  5,
+		"NETWORK_GSM":   6,
+	}
+)
+
+func (x StartEmulatorRequest_NetworkProfile) Enum() *StartEmulatorRequest_NetworkProfile {
+	p := new(StartEmulatorRequest_NetworkProfile)
+	*p = x
+	return p
+}
+
+func (x StartEmulatorRequest_NetworkProfile) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StartEmulatorRequest_NetworkProfile) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[5].Descriptor()
+}
+
+func (StartEmulatorRequest_NetworkProfile) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[5]
+}
+
+func (x StartEmulatorRequest_NetworkProfile) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StartEmulatorRequest_NetworkProfile.Descriptor instead.
+func (StartEmulatorRequest_NetworkProfile) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{4, 2}
+}
+
+type StartEmulatorRequest_Orientation int32
+
+const (
+	StartEmulatorRequest_PORTRAIT  StartEmulatorRequest_Orientation = 0
+	StartEmulatorRequest_LANDSCAPE StartEmulatorRequest_Orientation = 1
+)
+
+// Enum value maps for StartEmulatorRequest_Orientation.
+var (
+	StartEmulatorRequest_Orientation_name = map[int32]string{
+		0: "PORTRAIT",
+		1: "LANDSCAPE",
+	}
+	StartEmulatorRequest_Orientation_value = map[string]int32{
+		"PORTRAIT":  0,
+		"LANDSCAPE": 1,
+	}
+)
+
+func (x StartEmulatorRequest_Orientation) Enum() *StartEmulatorRequest_Orientation {
+	p := new(StartEmulatorRequest_Orientation)
+	*p = x
+	return p
+}
+
+func (x StartEmulatorRequest_Orientation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StartEmulatorRequest_Orientation) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[6].Descriptor()
+}
+
+func (StartEmulatorRequest_Orientation) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[6]
+}
+
+func (x StartEmulatorRequest_Orientation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StartEmulatorRequest_Orientation.Descriptor instead.
+func (StartEmulatorRequest_Orientation) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{4, 3}
+}
+
+type RestartPolicy_Mode int32
+
+const (
+	// Never restart the emulator.
+	RestartPolicy_NEVER RestartPolicy_Mode = 0
+	// Restart the emulator when it fails, up to max_retries consecutive failures.
+	RestartPolicy_ON_FAILURE RestartPolicy_Mode = 1
+	// Restart the emulator whenever it exits, including when the guest shuts down.
+	RestartPolicy_ALWAYS RestartPolicy_Mode = 2
+)
+
+// Enum value maps for RestartPolicy_Mode.
+var (
+	RestartPolicy_Mode_name = map[int32]string{
+		0: "NEVER",
+		1: "ON_FAILURE",
+		2: "ALWAYS",
+	}
+	RestartPolicy_Mode_value = map[string]int32{
+		"NEVER":      0,
+		"ON_FAILURE": 1,
+		"ALWAYS":     2,
+	}
+)
+
+func (x RestartPolicy_Mode) Enum() *RestartPolicy_Mode {
+	p := new(RestartPolicy_Mode)
+	*p = x
+	return p
+}
+
+func (x RestartPolicy_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestartPolicy_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[7].Descriptor()
+}
+
+func (RestartPolicy_Mode) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[7]
+}
+
+func (x RestartPolicy_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestartPolicy_Mode.Descriptor instead.
+func (RestartPolicy_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type VmState_RunState int32
+
+const (
+	// The state is unknown.
+	VmState_UNKNOWN VmState_RunState = 0
+	// The guest is running.
+	VmState_RUNNING VmState_RunState = 1
+	// The guest is paused to load a snapshot.
+	VmState_RESTORE_VM VmState_RunState = 2
+	// The guest has been paused.
+	VmState_PAUSED VmState_RunState = 3
+	// The guest is paused to save a snapshot.
+	VmState_SAVE_VM VmState_RunState = 4
+	// The guest is shutting down.
+	VmState_SHUTDOWN VmState_RunState = 5
+	// The guest has experienced an error.
+	VmState_INTERNAL_ERROR VmState_RunState = 6
+)
+
+// Enum value maps for VmState_RunState.
+var (
+	VmState_RunState_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "RUNNING",
+		2: "RESTORE_VM",
+		3: "PAUSED",
+		4: "SAVE_VM",
+		5: "SHUTDOWN",
+		6: "INTERNAL_ERROR",
+	}
+	VmState_RunState_value = map[string]int32{
+		"UNKNOWN":        0,
+		"RUNNING":        1,
+		"RESTORE_VM":     2,
+		"PAUSED":         3,
+		"SAVE_VM":        4,
+		"SHUTDOWN":       5,
+		"INTERNAL_ERROR": 6,
+	}
+)
+
+func (x VmState_RunState) Enum() *VmState_RunState {
+	p := new(VmState_RunState)
+	*p = x
+	return p
+}
+
+func (x VmState_RunState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VmState_RunState) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[8].Descriptor()
+}
+
+func (VmState_RunState) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[8]
+}
+
+func (x VmState_RunState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VmState_RunState.Descriptor instead.
+func (VmState_RunState) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type ScreenshotRequest_ImageFormat int32
+
+const (
+	// Portable Network Graphics.
+	ScreenshotRequest_PNG ScreenshotRequest_ImageFormat = 0
+	// 3 bytes per pixel.
+	ScreenshotRequest_RGB888 ScreenshotRequest_ImageFormat = 1
+)
+
+// Enum value maps for ScreenshotRequest_ImageFormat.
+var (
+	ScreenshotRequest_ImageFormat_name = map[int32]string{
+		0: "PNG",
+		1: "RGB888",
+	}
+	ScreenshotRequest_ImageFormat_value = map[string]int32{
+		"PNG":    0,
+		"RGB888": 1,
+	}
+)
+
+func (x ScreenshotRequest_ImageFormat) Enum() *ScreenshotRequest_ImageFormat {
+	p := new(ScreenshotRequest_ImageFormat)
+	*p = x
+	return p
+}
+
+func (x ScreenshotRequest_ImageFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScreenshotRequest_ImageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[9].Descriptor()
+}
+
+func (ScreenshotRequest_ImageFormat) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[9]
+}
+
+func (x ScreenshotRequest_ImageFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScreenshotRequest_ImageFormat.Descriptor instead.
+func (ScreenshotRequest_ImageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{21, 0}
+}
+
 type StreamDisplayRequest_FrameFormat int32
 
 const (
@@ -84,6 +604,8 @@ const (
 	StreamDisplayRequest_RGB888 StreamDisplayRequest_FrameFormat = 0
 	// VP8 codec. Uses intermediate frames.
 	StreamDisplayRequest_VP8 StreamDisplayRequest_FrameFormat = 1
+	// H.264 codec, baseline profile as an Annex B byte stream. Uses intermediate frames.
+	StreamDisplayRequest_H264 StreamDisplayRequest_FrameFormat = 2
 )
 
 // Enum value maps for StreamDisplayRequest_FrameFormat.
@@ -91,165 +613,2768 @@ var (
 	StreamDisplayRequest_FrameFormat_name = map[int32]string{
 		0: "RGB888",
 		1: "VP8",
+		2: "H264",
+	}
+	StreamDisplayRequest_FrameFormat_value = map[string]int32{
+		"RGB888": 0,
+		"VP8":    1,
+		"H264":   2,
+	}
+)
+
+func (x StreamDisplayRequest_FrameFormat) Enum() *StreamDisplayRequest_FrameFormat {
+	p := new(StreamDisplayRequest_FrameFormat)
+	*p = x
+	return p
+}
+
+func (x StreamDisplayRequest_FrameFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StreamDisplayRequest_FrameFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[10].Descriptor()
+}
+
+func (StreamDisplayRequest_FrameFormat) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[10]
+}
+
+func (x StreamDisplayRequest_FrameFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StreamDisplayRequest_FrameFormat.Descriptor instead.
+func (StreamDisplayRequest_FrameFormat) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type StartRecordingRequest_ContainerFormat int32
+
+const (
+	// WebM container with the VP8 codec.
+	StartRecordingRequest_WEBM StartRecordingRequest_ContainerFormat = 0
+	// MP4 container with the H.264 codec.
+	StartRecordingRequest_MP4 StartRecordingRequest_ContainerFormat = 1
+)
+
+// Enum value maps for StartRecordingRequest_ContainerFormat.
+var (
+	StartRecordingRequest_ContainerFormat_name = map[int32]string{
+		0: "WEBM",
+		1: "MP4",
+	}
+	StartRecordingRequest_ContainerFormat_value = map[string]int32{
+		"WEBM": 0,
+		"MP4":  1,
+	}
+)
+
+func (x StartRecordingRequest_ContainerFormat) Enum() *StartRecordingRequest_ContainerFormat {
+	p := new(StartRecordingRequest_ContainerFormat)
+	*p = x
+	return p
+}
+
+func (x StartRecordingRequest_ContainerFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StartRecordingRequest_ContainerFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[11].Descriptor()
+}
+
+func (StartRecordingRequest_ContainerFormat) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[11]
+}
+
+func (x StartRecordingRequest_ContainerFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StartRecordingRequest_ContainerFormat.Descriptor instead.
+func (StartRecordingRequest_ContainerFormat) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type Recording_RecordingState int32
+
+const (
+	// The recording is in progress.
+	Recording_RECORDING Recording_RecordingState = 0
+	// The recording has been stopped and can be downloaded.
+	Recording_FINISHED Recording_RecordingState = 1
+	// The recording failed. Any frames recorded before the failure can be downloaded.
+	Recording_FAILED Recording_RecordingState = 2
+)
+
+// Enum value maps for Recording_RecordingState.
+var (
+	Recording_RecordingState_name = map[int32]string{
+		0: "RECORDING",
+		1: "FINISHED",
+		2: "FAILED",
+	}
+	Recording_RecordingState_value = map[string]int32{
+		"RECORDING": 0,
+		"FINISHED":  1,
+		"FAILED":    2,
+	}
+)
+
+func (x Recording_RecordingState) Enum() *Recording_RecordingState {
+	p := new(Recording_RecordingState)
+	*p = x
+	return p
+}
+
+func (x Recording_RecordingState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Recording_RecordingState) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[12].Descriptor()
+}
+
+func (Recording_RecordingState) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[12]
+}
+
+func (x Recording_RecordingState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Recording_RecordingState.Descriptor instead.
+func (Recording_RecordingState) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{28, 0}
+}
+
+type SysLogEntry_Level int32
+
+const (
+	SysLogEntry_EMERGENCY SysLogEntry_Level = 0
+	SysLogEntry_ALERT     SysLogEntry_Level = 1
+	SysLogEntry_CRITICAL  SysLogEntry_Level = 2
+	SysLogEntry_ERROR     SysLogEntry_Level = 3
+	SysLogEntry_WARNING   SysLogEntry_Level = 4
+	SysLogEntry_NOTICE    SysLogEntry_Level = 5
+	SysLogEntry_INFO      SysLogEntry_Level = 6
+	SysLogEntry_DEBUG     SysLogEntry_Level = 7
+)
+
+// Enum value maps for SysLogEntry_Level.
+var (
+	SysLogEntry_Level_name = map[int32]string{
+		0: "EMERGENCY",
+		1: "ALERT",
+		2: "CRITICAL",
+		3: "ERROR",
+		4: "WARNING",
+		5: "NOTICE",
+		6: "INFO",
+		7: "DEBUG",
+	}
+	SysLogEntry_Level_value = map[string]int32{
+		"EMERGENCY": 0,
+		"ALERT":     1,
+		"CRITICAL":  2,
+		"ERROR":     3,
+		"WARNING":   4,
+		"NOTICE":    5,
+		"INFO":      6,
+		"DEBUG":     7,
+	}
+)
+
+func (x SysLogEntry_Level) Enum() *SysLogEntry_Level {
+	p := new(SysLogEntry_Level)
+	*p = x
+	return p
+}
+
+func (x SysLogEntry_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SysLogEntry_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[13].Descriptor()
+}
+
+func (SysLogEntry_Level) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[13]
+}
+
+func (x SysLogEntry_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SysLogEntry_Level.Descriptor instead.
+func (SysLogEntry_Level) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type EmulatorLogEntry_Severity int32
+
+const (
+	EmulatorLogEntry_VERBOSE EmulatorLogEntry_Severity = 0
+	EmulatorLogEntry_DEBUG   EmulatorLogEntry_Severity = 1
+	EmulatorLogEntry_INFO    EmulatorLogEntry_Severity = 2
+	EmulatorLogEntry_WARNING EmulatorLogEntry_Severity = 3
+	EmulatorLogEntry_ERROR   EmulatorLogEntry_Severity = 4
+	EmulatorLogEntry_FATAL   EmulatorLogEntry_Severity = 5
+)
+
+// Enum value maps for EmulatorLogEntry_Severity.
+var (
+	EmulatorLogEntry_Severity_name = map[int32]string{
+		0: "VERBOSE",
+		1: "DEBUG",
+		2: "INFO",
+		3: "WARNING",
+		4: "ERROR",
+		5: "FATAL",
+	}
+	EmulatorLogEntry_Severity_value = map[string]int32{
+		"VERBOSE": 0,
+		"DEBUG":   1,
+		"INFO":    2,
+		"WARNING": 3,
+		"ERROR":   4,
+		"FATAL":   5,
+	}
+)
+
+func (x EmulatorLogEntry_Severity) Enum() *EmulatorLogEntry_Severity {
+	p := new(EmulatorLogEntry_Severity)
+	*p = x
+	return p
+}
+
+func (x EmulatorLogEntry_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmulatorLogEntry_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[14].Descriptor()
+}
+
+func (EmulatorLogEntry_Severity) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[14]
+}
+
+func (x EmulatorLogEntry_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmulatorLogEntry_Severity.Descriptor instead.
+func (EmulatorLogEntry_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type EmulatorLogEntry_Stream int32
+
+const (
+	EmulatorLogEntry_STDOUT EmulatorLogEntry_Stream = 0
+	EmulatorLogEntry_STDERR EmulatorLogEntry_Stream = 1
+)
+
+// Enum value maps for EmulatorLogEntry_Stream.
+var (
+	EmulatorLogEntry_Stream_name = map[int32]string{
+		0: "STDOUT",
+		1: "STDERR",
+	}
+	EmulatorLogEntry_Stream_value = map[string]int32{
+		"STDOUT": 0,
+		"STDERR": 1,
+	}
+)
+
+func (x EmulatorLogEntry_Stream) Enum() *EmulatorLogEntry_Stream {
+	p := new(EmulatorLogEntry_Stream)
+	*p = x
+	return p
+}
+
+func (x EmulatorLogEntry_Stream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmulatorLogEntry_Stream) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[15].Descriptor()
+}
+
+func (EmulatorLogEntry_Stream) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[15]
+}
+
+func (x EmulatorLogEntry_Stream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmulatorLogEntry_Stream.Descriptor instead.
+func (EmulatorLogEntry_Stream) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{37, 1}
+}
+
+type KeyEvent_KeyEventType int32
+
+const (
+	// Press and hold the key
+	KeyEvent_DOWN KeyEvent_KeyEventType = 0
+	// Release the key
+	KeyEvent_UP KeyEvent_KeyEventType = 1
+	// Press and immediately release the key
+	KeyEvent_PRESS KeyEvent_KeyEventType = 2
+)
+
+// Enum value maps for KeyEvent_KeyEventType.
+var (
+	KeyEvent_KeyEventType_name = map[int32]string{
+		0: "DOWN",
+		1: "UP",
+		2: "PRESS",
+	}
+	KeyEvent_KeyEventType_value = map[string]int32{
+		"DOWN":  0,
+		"UP":    1,
+		"PRESS": 2,
+	}
+)
+
+func (x KeyEvent_KeyEventType) Enum() *KeyEvent_KeyEventType {
+	p := new(KeyEvent_KeyEventType)
+	*p = x
+	return p
+}
+
+func (x KeyEvent_KeyEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyEvent_KeyEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[16].Descriptor()
+}
+
+func (KeyEvent_KeyEventType) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[16]
+}
+
+func (x KeyEvent_KeyEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyEvent_KeyEventType.Descriptor instead.
+func (KeyEvent_KeyEventType) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{40, 0}
+}
+
+type ShellStartRequest_ShellType int32
+
+const (
+	// Do not spawn a PTY
+	ShellStartRequest_RAW ShellStartRequest_ShellType = 0
+	// Spawn a PTY
+	ShellStartRequest_PTY ShellStartRequest_ShellType = 1
+)
+
+// Enum value maps for ShellStartRequest_ShellType.
+var (
+	ShellStartRequest_ShellType_name = map[int32]string{
+		0: "RAW",
+		1: "PTY",
+	}
+	ShellStartRequest_ShellType_value = map[string]int32{
+		"RAW": 0,
+		"PTY": 1,
+	}
+)
+
+func (x ShellStartRequest_ShellType) Enum() *ShellStartRequest_ShellType {
+	p := new(ShellStartRequest_ShellType)
+	*p = x
+	return p
+}
+
+func (x ShellStartRequest_ShellType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShellStartRequest_ShellType) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[17].Descriptor()
+}
+
+func (ShellStartRequest_ShellType) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[17]
+}
+
+func (x ShellStartRequest_ShellType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShellStartRequest_ShellType.Descriptor instead.
+func (ShellStartRequest_ShellType) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{42, 0}
+}
+
+type ShellOutputResponse_ShellOutputChannel int32
+
+const (
+	// Stdout
+	ShellOutputResponse_OUT ShellOutputResponse_ShellOutputChannel = 0
+	// Stderr
+	ShellOutputResponse_ERR ShellOutputResponse_ShellOutputChannel = 1
+)
+
+// Enum value maps for ShellOutputResponse_ShellOutputChannel.
+var (
+	ShellOutputResponse_ShellOutputChannel_name = map[int32]string{
+		0: "OUT",
+		1: "ERR",
+	}
+	ShellOutputResponse_ShellOutputChannel_value = map[string]int32{
+		"OUT": 0,
+		"ERR": 1,
+	}
+)
+
+func (x ShellOutputResponse_ShellOutputChannel) Enum() *ShellOutputResponse_ShellOutputChannel {
+	p := new(ShellOutputResponse_ShellOutputChannel)
+	*p = x
+	return p
+}
+
+func (x ShellOutputResponse_ShellOutputChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShellOutputResponse_ShellOutputChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[18].Descriptor()
+}
+
+func (ShellOutputResponse_ShellOutputChannel) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[18]
+}
+
+func (x ShellOutputResponse_ShellOutputChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShellOutputResponse_ShellOutputChannel.Descriptor instead.
+func (ShellOutputResponse_ShellOutputChannel) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{46, 0}
+}
+
+// AgentState describes the current state of the agent process and the virtual machine being controlled.
+type AgentState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state of the emulator.
+	EmulatorState AgentState_EmulatorState `protobuf:"varint,1,opt,name=emulator_state,json=emulatorState,proto3,enum=AgentState_EmulatorState" json:"emulator_state,omitempty"`
+	// The error message associated with the error state.
+	EmulatorError *string `protobuf:"bytes,2,opt,name=emulator_error,json=emulatorError,proto3,oneof" json:"emulator_error,omitempty"`
+	// The furthest boot stage reached by the current emulator.
+	BootStage AgentState_BootStage `protobuf:"varint,3,opt,name=boot_stage,json=bootStage,proto3,enum=AgentState_BootStage" json:"boot_stage,omitempty"`
+	// The time each boot stage was reached, in order.
+	BootProgress []*BootProgress `protobuf:"bytes,4,rep,name=boot_progress,json=bootProgress,proto3" json:"boot_progress,omitempty"`
+	// The number of times the emulator has been restarted by the restart policy since the last startEmulator request.
+	RestartCount uint32 `protobuf:"varint,5,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	// The reason the emulator last failed, which may have caused a restart.
+	LastFailure *string `protobuf:"bytes,6,opt,name=last_failure,json=lastFailure,proto3,oneof" json:"last_failure,omitempty"`
+	// The time a restart is scheduled for, in milliseconds since the unix epoch.
+	NextRestart *uint64 `protobuf:"varint,7,opt,name=next_restart,json=nextRestart,proto3,oneof" json:"next_restart,omitempty"`
+	// The configuration the current emulator was started with.
+	Config *StartEmulatorRequest `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
+	// The version of the current emulator, as reported by the emulator once connected.
+	EmulatorVersion *string `protobuf:"bytes,9,opt,name=emulator_version,json=emulatorVersion,proto3,oneof" json:"emulator_version,omitempty"`
+	// The system image the current emulator was started with.
+	SystemImage *SystemImage `protobuf:"bytes,10,opt,name=system_image,json=systemImage,proto3" json:"system_image,omitempty"`
+	// The time the current emulator was started, in milliseconds since the unix epoch.
+	StartedAt *uint64 `protobuf:"varint,11,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	// The time the current emulator finished booting, in milliseconds since the unix epoch.
+	BootedAt *uint64 `protobuf:"varint,12,opt,name=booted_at,json=bootedAt,proto3,oneof" json:"booted_at,omitempty"`
+	// The time in milliseconds since the current emulator was started. Zero when the emulator is not running.
+	Uptime uint64 `protobuf:"varint,13,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// The adb serial of the current emulator, once adb has connected.
+	AdbSerial *string `protobuf:"bytes,14,opt,name=adb_serial,json=adbSerial,proto3,oneof" json:"adb_serial,omitempty"`
+	// Whether adbd is running as root on the current emulator, once booted.
+	AdbRoot bool `protobuf:"varint,15,opt,name=adb_root,json=adbRoot,proto3" json:"adb_root,omitempty"`
+	// Incremented whenever the agent state changes. Changes to the uptime alone are not counted.
+	Sequence uint64 `protobuf:"varint,16,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The emulator state before the most recent change of emulator state.
+	PreviousState *AgentState_EmulatorState `protobuf:"varint,17,opt,name=previous_state,json=previousState,proto3,enum=AgentState_EmulatorState,oneof" json:"previous_state,omitempty"`
+	// The fatal events detected in the output of the current emulator, in order.
+	FatalEvents []*FatalEvent `protobuf:"bytes,18,rep,name=fatal_events,json=fatalEvents,proto3" json:"fatal_events,omitempty"`
+}
+
+func (x *AgentState) Reset() {
+	*x = AgentState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentState) ProtoMessage() {}
+
+func (x *AgentState) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentState.ProtoReflect.Descriptor instead.
+func (*AgentState) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AgentState) GetEmulatorState() AgentState_EmulatorState {
+	if x != nil {
+		return x.EmulatorState
+	}
+	return AgentState_ERROR
+}
+
+func (x *AgentState) GetEmulatorError() string {
+	if x != nil && x.EmulatorError != nil {
+		return *x.EmulatorError
+	}
+	return ""
+}
+
+func (x *AgentState) GetBootStage() AgentState_BootStage {
+	if x != nil {
+		return x.BootStage
+	}
+	return AgentState_NOT_BOOTED
+}
+
+func (x *AgentState) GetBootProgress() []*BootProgress {
+	if x != nil {
+		return x.BootProgress
+	}
+	return nil
+}
+
+func (x *AgentState) GetRestartCount() uint32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *AgentState) GetLastFailure() string {
+	if x != nil && x.LastFailure != nil {
+		return *x.LastFailure
+	}
+	return ""
+}
+
+func (x *AgentState) GetNextRestart() uint64 {
+	if x != nil && x.NextRestart != nil {
+		return *x.NextRestart
+	}
+	return 0
+}
+
+func (x *AgentState) GetConfig() *StartEmulatorRequest {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AgentState) GetEmulatorVersion() string {
+	if x != nil && x.EmulatorVersion != nil {
+		return *x.EmulatorVersion
+	}
+	return ""
+}
+
+func (x *AgentState) GetSystemImage() *SystemImage {
+	if x != nil {
+		return x.SystemImage
+	}
+	return nil
+}
+
+func (x *AgentState) GetStartedAt() uint64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
+}
+
+func (x *AgentState) GetBootedAt() uint64 {
+	if x != nil && x.BootedAt != nil {
+		return *x.BootedAt
+	}
+	return 0
+}
+
+func (x *AgentState) GetUptime() uint64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *AgentState) GetAdbSerial() string {
+	if x != nil && x.AdbSerial != nil {
+		return *x.AdbSerial
+	}
+	return ""
+}
+
+func (x *AgentState) GetAdbRoot() bool {
+	if x != nil {
+		return x.AdbRoot
+	}
+	return false
+}
+
+func (x *AgentState) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AgentState) GetPreviousState() AgentState_EmulatorState {
+	if x != nil && x.PreviousState != nil {
+		return *x.PreviousState
+	}
+	return AgentState_ERROR
+}
+
+func (x *AgentState) GetFatalEvents() []*FatalEvent {
+	if x != nil {
+		return x.FatalEvents
+	}
+	return nil
+}
+
+// A fatal failure of the guest, detected in the syslog or emulator output.
+type FatalEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type FatalEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=FatalEvent_Type" json:"type,omitempty"`
+	// The time the event was detected, in milliseconds since the unix epoch.
+	Time uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// The line the event was detected from.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The lines leading up to and including the detected line.
+	Excerpt []string `protobuf:"bytes,4,rep,name=excerpt,proto3" json:"excerpt,omitempty"`
+}
+
+func (x *FatalEvent) Reset() {
+	*x = FatalEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FatalEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FatalEvent) ProtoMessage() {}
+
+func (x *FatalEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FatalEvent.ProtoReflect.Descriptor instead.
+func (*FatalEvent) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FatalEvent) GetType() FatalEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return FatalEvent_KERNEL_PANIC
+}
+
+func (x *FatalEvent) GetTime() uint64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *FatalEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FatalEvent) GetExcerpt() []string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return nil
+}
+
+type StreamStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only deliver states that differ from the previously delivered state, rather than periodically repeating the
+	// current state. Intermediate states may be skipped by slow consumers, which can be detected using the sequence.
+	TransitionsOnly bool `protobuf:"varint,1,opt,name=transitions_only,json=transitionsOnly,proto3" json:"transitions_only,omitempty"`
+}
+
+func (x *StreamStateRequest) Reset() {
+	*x = StreamStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStateRequest) ProtoMessage() {}
+
+func (x *StreamStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStateRequest.ProtoReflect.Descriptor instead.
+func (*StreamStateRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamStateRequest) GetTransitionsOnly() bool {
+	if x != nil {
+		return x.TransitionsOnly
+	}
+	return false
+}
+
+// The time a boot stage was reached.
+type BootProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage AgentState_BootStage `protobuf:"varint,1,opt,name=stage,proto3,enum=AgentState_BootStage" json:"stage,omitempty"`
+	// Milliseconds since the unix epoch.
+	Time uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *BootProgress) Reset() {
+	*x = BootProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootProgress) ProtoMessage() {}
+
+func (x *BootProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootProgress.ProtoReflect.Descriptor instead.
+func (*BootProgress) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BootProgress) GetStage() AgentState_BootStage {
+	if x != nil {
+		return x.Stage
+	}
+	return AgentState_NOT_BOOTED
+}
+
+func (x *BootProgress) GetTime() uint64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// Requests the emulator boots with the given configuration.
+// Example Settings:
+//
+//	Ram: 2048 Cores: 1
+//	Display 720x1280 320dpi
+type StartEmulatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Memory in MBs
+	RamSize uint32 `protobuf:"varint,1,opt,name=ram_size,json=ramSize,proto3" json:"ram_size,omitempty"`
+	// Number of cores.
+	CoreCount uint32 `protobuf:"varint,2,opt,name=core_count,json=coreCount,proto3" json:"core_count,omitempty"`
+	// The DPI of the main display.
+	LcdDensity uint32 `protobuf:"varint,3,opt,name=lcd_density,json=lcdDensity,proto3" json:"lcd_density,omitempty"`
+	// The width of the main display.
+	LcdWidth uint32 `protobuf:"varint,4,opt,name=lcd_width,json=lcdWidth,proto3" json:"lcd_width,omitempty"`
+	// The height of the main display.
+	LcdHeight uint32 `protobuf:"varint,5,opt,name=lcd_height,json=lcdHeight,proto3" json:"lcd_height,omitempty"`
+	// Whether to root the adb session on start
+	RootAdb bool `protobuf:"varint,6,opt,name=root_adb,json=rootAdb,proto3" json:"root_adb,omitempty"`
+	// Additional displays to attach once the emulator has booted.
+	// Display ids are assigned in order, starting from 1.
+	SecondaryDisplays []*SecondaryDisplay `protobuf:"bytes,7,rep,name=secondary_displays,json=secondaryDisplays,proto3" json:"secondary_displays,omitempty"`
+	// The size of the data partition in MBs. Set to 0 to use the default of 512.
+	DataPartitionSize uint32 `protobuf:"varint,8,opt,name=data_partition_size,json=dataPartitionSize,proto3" json:"data_partition_size,omitempty"`
+	// The size of the SD card in MBs. Set to 0 to disable the SD card.
+	SdcardSize uint32 `protobuf:"varint,9,opt,name=sdcard_size,json=sdcardSize,proto3" json:"sdcard_size,omitempty"`
+	// How graphics are rendered. When unset, the AVD leaves the mode to the emulator and the emulator renders using
+	// SwiftShader.
+	GpuMode *StartEmulatorRequest_GpuMode `protobuf:"varint,10,opt,name=gpu_mode,json=gpuMode,proto3,enum=StartEmulatorRequest_GpuMode,oneof" json:"gpu_mode,omitempty"`
+	// The back facing camera.
+	CameraBack StartEmulatorRequest_Camera `protobuf:"varint,11,opt,name=camera_back,json=cameraBack,proto3,enum=StartEmulatorRequest_Camera" json:"camera_back,omitempty"`
+	// The front facing camera.
+	CameraFront StartEmulatorRequest_Camera `protobuf:"varint,12,opt,name=camera_front,json=cameraFront,proto3,enum=StartEmulatorRequest_Camera" json:"camera_front,omitempty"`
+	// Whether a hardware keyboard is attached. Defaults to true.
+	Keyboard *bool `protobuf:"varint,13,opt,name=keyboard,proto3,oneof" json:"keyboard,omitempty"`
+	// The simulated network speed and latency.
+	NetworkProfile StartEmulatorRequest_NetworkProfile `protobuf:"varint,14,opt,name=network_profile,json=networkProfile,proto3,enum=StartEmulatorRequest_NetworkProfile" json:"network_profile,omitempty"`
+	// The initial orientation of the device.
+	Orientation StartEmulatorRequest_Orientation `protobuf:"varint,15,opt,name=orientation,proto3,enum=StartEmulatorRequest_Orientation" json:"orientation,omitempty"`
+	// The maximum heap size of an application in MBs. Set to 0 to use the image default.
+	HeapSize uint32 `protobuf:"varint,16,opt,name=heap_size,json=heapSize,proto3" json:"heap_size,omitempty"`
+	// Additional "hw.*" hardware properties to write to the AVD config. Only known properties that are not covered by
+	// another field are accepted.
+	HardwareProperties map[string]string `protobuf:"bytes,17,rep,name=hardware_properties,json=hardwareProperties,proto3" json:"hardware_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Images to boot in place of those provided by the system image.
+	ImageOverrides *ImageOverrides `protobuf:"bytes,18,opt,name=image_overrides,json=imageOverrides,proto3" json:"image_overrides,omitempty"`
+	// The name of the system image to boot, as reported by listSystemImages. May be left empty when only a single system
+	// image is installed.
+	SystemImage string `protobuf:"bytes,19,opt,name=system_image,json=systemImage,proto3" json:"system_image,omitempty"`
+	// Whether to keep the data partition and SD card of the previous boot, rather than wiping them.
+	KeepUserData bool `protobuf:"varint,20,opt,name=keep_user_data,json=keepUserData,proto3" json:"keep_user_data,omitempty"`
+	// The name of a snapshot to boot from, as reported by listSnapshots. Implies keep_user_data. The hardware
+	// configuration must match the configuration the snapshot was saved with, otherwise the emulator cold boots.
+	Snapshot string `protobuf:"bytes,21,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// The maximum time in seconds to wait for android to finish booting, after which the emulator is killed. Set to 0 to
+	// use the default of 300 seconds.
+	BootTimeout uint32 `protobuf:"varint,22,opt,name=boot_timeout,json=bootTimeout,proto3" json:"boot_timeout,omitempty"`
+	// The interval in seconds between health checks of the booted emulator. An emulator that fails several consecutive
+	// checks is killed. Set to 0 to use the default of 5 seconds.
+	HealthCheckInterval uint32 `protobuf:"varint,23,opt,name=health_check_interval,json=healthCheckInterval,proto3" json:"health_check_interval,omitempty"`
+	// How the emulator is restarted once it exits without being requested to stop.
+	RestartPolicy *RestartPolicy `protobuf:"bytes,24,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+}
+
+func (x *StartEmulatorRequest) Reset() {
+	*x = StartEmulatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartEmulatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEmulatorRequest) ProtoMessage() {}
+
+func (x *StartEmulatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEmulatorRequest.ProtoReflect.Descriptor instead.
+func (*StartEmulatorRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StartEmulatorRequest) GetRamSize() uint32 {
+	if x != nil {
+		return x.RamSize
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetCoreCount() uint32 {
+	if x != nil {
+		return x.CoreCount
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetLcdDensity() uint32 {
+	if x != nil {
+		return x.LcdDensity
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetLcdWidth() uint32 {
+	if x != nil {
+		return x.LcdWidth
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetLcdHeight() uint32 {
+	if x != nil {
+		return x.LcdHeight
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetRootAdb() bool {
+	if x != nil {
+		return x.RootAdb
+	}
+	return false
+}
+
+func (x *StartEmulatorRequest) GetSecondaryDisplays() []*SecondaryDisplay {
+	if x != nil {
+		return x.SecondaryDisplays
+	}
+	return nil
+}
+
+func (x *StartEmulatorRequest) GetDataPartitionSize() uint32 {
+	if x != nil {
+		return x.DataPartitionSize
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetSdcardSize() uint32 {
+	if x != nil {
+		return x.SdcardSize
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetGpuMode() StartEmulatorRequest_GpuMode {
+	if x != nil && x.GpuMode != nil {
+		return *x.GpuMode
+	}
+	return StartEmulatorRequest_GPU_SWIFTSHADER_INDIRECT
+}
+
+func (x *StartEmulatorRequest) GetCameraBack() StartEmulatorRequest_Camera {
+	if x != nil {
+		return x.CameraBack
+	}
+	return StartEmulatorRequest_CAMERA_EMULATED
+}
+
+func (x *StartEmulatorRequest) GetCameraFront() StartEmulatorRequest_Camera {
+	if x != nil {
+		return x.CameraFront
+	}
+	return StartEmulatorRequest_CAMERA_EMULATED
+}
+
+func (x *StartEmulatorRequest) GetKeyboard() bool {
+	if x != nil && x.Keyboard != nil {
+		return *x.Keyboard
+	}
+	return false
+}
+
+func (x *StartEmulatorRequest) GetNetworkProfile() StartEmulatorRequest_NetworkProfile {
+	if x != nil {
+		return x.NetworkProfile
+	}
+	return StartEmulatorRequest_NETWORK_FULL
+}
+
+func (x *StartEmulatorRequest) GetOrientation() StartEmulatorRequest_Orientation {
+	if x != nil {
+		return x.Orientation
+	}
+	return StartEmulatorRequest_PORTRAIT
+}
+
+func (x *StartEmulatorRequest) GetHeapSize() uint32 {
+	if x != nil {
+		return x.HeapSize
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetHardwareProperties() map[string]string {
+	if x != nil {
+		return x.HardwareProperties
+	}
+	return nil
+}
+
+func (x *StartEmulatorRequest) GetImageOverrides() *ImageOverrides {
+	if x != nil {
+		return x.ImageOverrides
+	}
+	return nil
+}
+
+func (x *StartEmulatorRequest) GetSystemImage() string {
+	if x != nil {
+		return x.SystemImage
+	}
+	return ""
+}
+
+func (x *StartEmulatorRequest) GetKeepUserData() bool {
+	if x != nil {
+		return x.KeepUserData
+	}
+	return false
+}
+
+func (x *StartEmulatorRequest) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *StartEmulatorRequest) GetBootTimeout() uint32 {
+	if x != nil {
+		return x.BootTimeout
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetHealthCheckInterval() uint32 {
+	if x != nil {
+		return x.HealthCheckInterval
+	}
+	return 0
+}
+
+func (x *StartEmulatorRequest) GetRestartPolicy() *RestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return nil
+}
+
+// Controls how the emulator is restarted once it exits without being requested to stop. Restarts are delayed by a
+// backoff that doubles with each consecutive failure, resetting once the emulator boots.
+type RestartPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode RestartPolicy_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=RestartPolicy_Mode" json:"mode,omitempty"`
+	// The maximum number of consecutive failures to restart after. Set to 0 for no limit.
+	MaxRetries uint32 `protobuf:"varint,2,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// The delay in seconds before the first restart. Set to 0 to use the default of 5 seconds.
+	Backoff uint32 `protobuf:"varint,3,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// The maximum delay in seconds between restarts. Set to 0 to use the default of 300 seconds.
+	MaxBackoff uint32 `protobuf:"varint,4,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+}
+
+func (x *RestartPolicy) Reset() {
+	*x = RestartPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestartPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartPolicy) ProtoMessage() {}
+
+func (x *RestartPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartPolicy.ProtoReflect.Descriptor instead.
+func (*RestartPolicy) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RestartPolicy) GetMode() RestartPolicy_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return RestartPolicy_NEVER
+}
+
+func (x *RestartPolicy) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetBackoff() uint32 {
+	if x != nil {
+		return x.Backoff
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetMaxBackoff() uint32 {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return 0
+}
+
+// Images to boot in place of those provided by the system image. Each image is either an absolute path within the agent
+// container, or the name of an image uploaded using uploadImage. The kernel, ramdisk, system and vendor images must
+// match the ABI of the system image.
+type ImageOverrides struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kernel image.
+	Kernel *string `protobuf:"bytes,1,opt,name=kernel,proto3,oneof" json:"kernel,omitempty"`
+	// The system partition image.
+	System *string `protobuf:"bytes,2,opt,name=system,proto3,oneof" json:"system,omitempty"`
+	// The vendor partition image.
+	Vendor *string `protobuf:"bytes,3,opt,name=vendor,proto3,oneof" json:"vendor,omitempty"`
+	// The ramdisk image. May be compressed using gzip or lz4.
+	Ramdisk *string `protobuf:"bytes,4,opt,name=ramdisk,proto3,oneof" json:"ramdisk,omitempty"`
+	// The initial data partition image. The image is copied on boot and is never modified.
+	Data *string `protobuf:"bytes,5,opt,name=data,proto3,oneof" json:"data,omitempty"`
+}
+
+func (x *ImageOverrides) Reset() {
+	*x = ImageOverrides{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageOverrides) ProtoMessage() {}
+
+func (x *ImageOverrides) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageOverrides.ProtoReflect.Descriptor instead.
+func (*ImageOverrides) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImageOverrides) GetKernel() string {
+	if x != nil && x.Kernel != nil {
+		return *x.Kernel
+	}
+	return ""
+}
+
+func (x *ImageOverrides) GetSystem() string {
+	if x != nil && x.System != nil {
+		return *x.System
+	}
+	return ""
+}
+
+func (x *ImageOverrides) GetVendor() string {
+	if x != nil && x.Vendor != nil {
+		return *x.Vendor
+	}
+	return ""
+}
+
+func (x *ImageOverrides) GetRamdisk() string {
+	if x != nil && x.Ramdisk != nil {
+		return *x.Ramdisk
+	}
+	return ""
+}
+
+func (x *ImageOverrides) GetData() string {
+	if x != nil && x.Data != nil {
+		return *x.Data
+	}
+	return ""
+}
+
+// The run state of the virtual machine.
+type VmState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State VmState_RunState `protobuf:"varint,1,opt,name=state,proto3,enum=VmState_RunState" json:"state,omitempty"`
+}
+
+func (x *VmState) Reset() {
+	*x = VmState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VmState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VmState) ProtoMessage() {}
+
+func (x *VmState) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VmState.ProtoReflect.Descriptor instead.
+func (*VmState) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VmState) GetState() VmState_RunState {
+	if x != nil {
+		return x.State
+	}
+	return VmState_UNKNOWN
+}
+
+type ListSystemImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Images []*SystemImage `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+}
+
+func (x *ListSystemImagesResponse) Reset() {
+	*x = ListSystemImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSystemImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemImagesResponse) ProtoMessage() {}
+
+func (x *ListSystemImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListSystemImagesResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSystemImagesResponse) GetImages() []*SystemImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// A system image installed in the agent container.
+type SystemImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the image, used to select it when starting the emulator.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The Android API level.
+	ApiLevel uint32 `protobuf:"varint,2,opt,name=api_level,json=apiLevel,proto3" json:"api_level,omitempty"`
+	// The primary ABI, for example "x86_64".
+	Abi string `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
+	// The variant of the image, for example "default", "google_apis" or "google_apis_playstore".
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *SystemImage) Reset() {
+	*x = SystemImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemImage) ProtoMessage() {}
+
+func (x *SystemImage) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemImage.ProtoReflect.Descriptor instead.
+func (*SystemImage) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SystemImage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemImage) GetApiLevel() uint32 {
+	if x != nil {
+		return x.ApiLevel
+	}
+	return 0
+}
+
+func (x *SystemImage) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+func (x *SystemImage) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// Requests the running emulator is saved as a snapshot.
+type SaveSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the snapshot. May contain letters, digits, '_', '.' and '-'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SaveSnapshotRequest) Reset() {
+	*x = SaveSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSnapshotRequest) ProtoMessage() {}
+
+func (x *SaveSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SaveSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SaveSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Requests the running emulator is restored to a snapshot.
+type LoadSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the snapshot.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *LoadSnapshotRequest) Reset() {
+	*x = LoadSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSnapshotRequest) ProtoMessage() {}
+
+func (x *LoadSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*LoadSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LoadSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Requests a snapshot is deleted.
+type DeleteSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the snapshot.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteSnapshotRequest) Reset() {
+	*x = DeleteSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Requests a snapshot is exported.
+type ExportSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the snapshot.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ExportSnapshotRequest) Reset() {
+	*x = ExportSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSnapshotRequest) ProtoMessage() {}
+
+func (x *ExportSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*ExportSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A chunk of an exported snapshot archive.
+type ExportSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportSnapshotResponse) Reset() {
+	*x = ExportSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSnapshotResponse) ProtoMessage() {}
+
+func (x *ExportSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*ExportSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportSnapshotResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A chunk of a snapshot archive to import.
+type ImportSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ImportSnapshotRequest) Reset() {
+	*x = ImportSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSnapshotRequest) ProtoMessage() {}
+
+func (x *ImportSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*ImportSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportSnapshotRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// A stored snapshot of the emulator.
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the snapshot.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The size of the snapshot on disk in bytes.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// When the snapshot was saved, in seconds since the unix epoch.
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Snapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Snapshot) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Snapshot) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// The configuration of a secondary display.
+type SecondaryDisplay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The width of the display.
+	Width uint32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	// The height of the display.
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The DPI of the display.
+	Density uint32 `protobuf:"varint,3,opt,name=density,proto3" json:"density,omitempty"`
+	// A combination of android virtual display flags:
+	//   1: VIRTUAL_DISPLAY_FLAG_PUBLIC
+	//   2: VIRTUAL_DISPLAY_FLAG_PRESENTATION
+	//   4: VIRTUAL_DISPLAY_FLAG_SECURE
+	//   8: VIRTUAL_DISPLAY_FLAG_OWN_CONTENT_ONLY
+	//   16: VIRTUAL_DISPLAY_FLAG_AUTO_MIRROR
+	Flags uint32 `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *SecondaryDisplay) Reset() {
+	*x = SecondaryDisplay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecondaryDisplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecondaryDisplay) ProtoMessage() {}
+
+func (x *SecondaryDisplay) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecondaryDisplay.ProtoReflect.Descriptor instead.
+func (*SecondaryDisplay) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SecondaryDisplay) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *SecondaryDisplay) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SecondaryDisplay) GetDensity() uint32 {
+	if x != nil {
+		return x.Density
+	}
+	return 0
+}
+
+func (x *SecondaryDisplay) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+// A display listing response.
+type ListDisplaysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Displays []*Display `protobuf:"bytes,1,rep,name=displays,proto3" json:"displays,omitempty"`
+}
+
+func (x *ListDisplaysResponse) Reset() {
+	*x = ListDisplaysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDisplaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisplaysResponse) ProtoMessage() {}
+
+func (x *ListDisplaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisplaysResponse.ProtoReflect.Descriptor instead.
+func (*ListDisplaysResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDisplaysResponse) GetDisplays() []*Display {
+	if x != nil {
+		return x.Displays
+	}
+	return nil
+}
+
+// A display attached to the emulator.
+type Display struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the display. The main display has the id 0.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The width of the display.
+	Width uint32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	// The height of the display.
+	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The DPI of the display.
+	Density uint32 `protobuf:"varint,4,opt,name=density,proto3" json:"density,omitempty"`
+	// A combination of android virtual display flags. See SecondaryDisplay.
+	Flags uint32 `protobuf:"varint,5,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *Display) Reset() {
+	*x = Display{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Display) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Display) ProtoMessage() {}
+
+func (x *Display) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Display.ProtoReflect.Descriptor instead.
+func (*Display) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Display) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Display) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Display) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Display) GetDensity() uint32 {
+	if x != nil {
+		return x.Density
+	}
+	return 0
+}
+
+func (x *Display) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+// Requests a screenshot of a display.
+type ScreenshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the display. The main display has the id 0.
+	Display uint32 `protobuf:"varint,1,opt,name=display,proto3" json:"display,omitempty"`
+	// The image encoding format.
+	Format ScreenshotRequest_ImageFormat `protobuf:"varint,2,opt,name=format,proto3,enum=ScreenshotRequest_ImageFormat" json:"format,omitempty"`
+}
+
+func (x *ScreenshotRequest) Reset() {
+	*x = ScreenshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreenshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenshotRequest) ProtoMessage() {}
+
+func (x *ScreenshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenshotRequest.ProtoReflect.Descriptor instead.
+func (*ScreenshotRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ScreenshotRequest) GetDisplay() uint32 {
+	if x != nil {
+		return x.Display
+	}
+	return 0
+}
+
+func (x *ScreenshotRequest) GetFormat() ScreenshotRequest_ImageFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ScreenshotRequest_PNG
+}
+
+// A single screenshot.
+type Screenshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The width of the image.
+	Width uint32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	// The height of the image.
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The encoded image data.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Screenshot) Reset() {
+	*x = Screenshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Screenshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Screenshot) ProtoMessage() {}
+
+func (x *Screenshot) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Screenshot.ProtoReflect.Descriptor instead.
+func (*Screenshot) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Screenshot) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Screenshot) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Screenshot) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Requests the emulator exits.
+type StopEmulatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kills the emulator without waiting.
+	ForceExit bool `protobuf:"varint,1,opt,name=force_exit,json=forceExit,proto3" json:"force_exit,omitempty"`
+	// The time in seconds to wait for a graceful stop, after which the emulator is killed. Set to 0 to use the default of
+	// 30 seconds.
+	Timeout uint32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *StopEmulatorRequest) Reset() {
+	*x = StopEmulatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopEmulatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopEmulatorRequest) ProtoMessage() {}
+
+func (x *StopEmulatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopEmulatorRequest.ProtoReflect.Descriptor instead.
+func (*StopEmulatorRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StopEmulatorRequest) GetForceExit() bool {
+	if x != nil {
+		return x.ForceExit
+	}
+	return false
+}
+
+func (x *StopEmulatorRequest) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// Requests the display is streamed with the given configuration.
+type StreamDisplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The frame encoding format.
+	Format StreamDisplayRequest_FrameFormat `protobuf:"varint,1,opt,name=format,proto3,enum=StreamDisplayRequest_FrameFormat" json:"format,omitempty"`
+	// The maximum number of frames to encode per second.
+	// Extra frames will be dropped, with the most recent frame encoded every 1/max_fps seconds.
+	// Set to 0 to disable limit.
+	MaxFps uint32 `protobuf:"varint,2,opt,name=max_fps,json=maxFps,proto3" json:"max_fps,omitempty"`
+	// How often in milliseconds to encode a keyframe.
+	// Set to 0 to only send when required. Not all formats use intermediate frames.
+	KeyframeInterval uint32 `protobuf:"varint,3,opt,name=keyframe_interval,json=keyframeInterval,proto3" json:"keyframe_interval,omitempty"`
+	// The id of the display to stream. The main display has the id 0.
+	// Frames for secondary displays are only produced once the display has been attached.
+	Display uint32 `protobuf:"varint,4,opt,name=display,proto3" json:"display,omitempty"`
+}
+
+func (x *StreamDisplayRequest) Reset() {
+	*x = StreamDisplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamDisplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDisplayRequest) ProtoMessage() {}
+
+func (x *StreamDisplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDisplayRequest.ProtoReflect.Descriptor instead.
+func (*StreamDisplayRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamDisplayRequest) GetFormat() StreamDisplayRequest_FrameFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StreamDisplayRequest_RGB888
+}
+
+func (x *StreamDisplayRequest) GetMaxFps() uint32 {
+	if x != nil {
+		return x.MaxFps
+	}
+	return 0
+}
+
+func (x *StreamDisplayRequest) GetKeyframeInterval() uint32 {
+	if x != nil {
+		return x.KeyframeInterval
+	}
+	return 0
+}
+
+func (x *StreamDisplayRequest) GetDisplay() uint32 {
+	if x != nil {
+		return x.Display
+	}
+	return 0
+}
+
+// A single display frame.
+// Based on the format requested, this may not be a keyframe.
+// A frame with zero width and height signifies the display is off. Clients should display a blank screen.
+// The width and height can change on a keyframe, which can occur if the emulator is restarted with a different
+// configuration.
+type DisplayFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether this is a key frame. For some formats, this will always be true.
+	Keyframe bool `protobuf:"varint,1,opt,name=keyframe,proto3" json:"keyframe,omitempty"`
+	// The width of the frame.
+	Width uint32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	// The height of the frame.
+	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The raw frame data.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// The id of the stream that produced this frame. Used to request keyframes.
+	StreamId uint64 `protobuf:"varint,5,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (x *DisplayFrame) Reset() {
+	*x = DisplayFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisplayFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayFrame) ProtoMessage() {}
+
+func (x *DisplayFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayFrame.ProtoReflect.Descriptor instead.
+func (*DisplayFrame) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DisplayFrame) GetKeyframe() bool {
+	if x != nil {
+		return x.Keyframe
+	}
+	return false
+}
+
+func (x *DisplayFrame) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *DisplayFrame) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DisplayFrame) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DisplayFrame) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+// Requests a keyframe for the given display stream.
+type RequestKeyframeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the stream, as reported in DisplayFrame.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (x *RequestKeyframeRequest) Reset() {
+	*x = RequestKeyframeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestKeyframeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestKeyframeRequest) ProtoMessage() {}
+
+func (x *RequestKeyframeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestKeyframeRequest.ProtoReflect.Descriptor instead.
+func (*RequestKeyframeRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RequestKeyframeRequest) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+// Requests a display is recorded with the given configuration.
+type StartRecordingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the display to record. The main display has the id 0.
+	Display uint32 `protobuf:"varint,1,opt,name=display,proto3" json:"display,omitempty"`
+	// The container format.
+	Format StartRecordingRequest_ContainerFormat `protobuf:"varint,2,opt,name=format,proto3,enum=StartRecordingRequest_ContainerFormat" json:"format,omitempty"`
+	// The maximum number of frames to record per second.
+	// Set to 0 to use the default of 30.
+	MaxFps uint32 `protobuf:"varint,3,opt,name=max_fps,json=maxFps,proto3" json:"max_fps,omitempty"`
+	// Whether to draw indicators at active touch points.
+	ShowTouches bool `protobuf:"varint,4,opt,name=show_touches,json=showTouches,proto3" json:"show_touches,omitempty"`
+}
+
+func (x *StartRecordingRequest) Reset() {
+	*x = StartRecordingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRecordingRequest) ProtoMessage() {}
+
+func (x *StartRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	StreamDisplayRequest_FrameFormat_value = map[string]int32{
-		"RGB888": 0,
-		"VP8":    1,
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StartRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StartRecordingRequest) GetDisplay() uint32 {
+	if x != nil {
+		return x.Display
 	}
-)
+	return 0
+}
 
-func (x StreamDisplayRequest_FrameFormat) Enum() *StreamDisplayRequest_FrameFormat {
-	p := new(StreamDisplayRequest_FrameFormat)
-	*p = x
-	return p
+func (x *StartRecordingRequest) GetFormat() StartRecordingRequest_ContainerFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StartRecordingRequest_WEBM
 }
 
-func (x StreamDisplayRequest_FrameFormat) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *StartRecordingRequest) GetMaxFps() uint32 {
+	if x != nil {
+		return x.MaxFps
+	}
+	return 0
 }
 
-func (StreamDisplayRequest_FrameFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_agent_proto_enumTypes[1].Descriptor()
+func (x *StartRecordingRequest) GetShowTouches() bool {
+	if x != nil {
+		return x.ShowTouches
+	}
+	return false
 }
 
-func (StreamDisplayRequest_FrameFormat) Type() protoreflect.EnumType {
-	return &file_agent_proto_enumTypes[1]
+// A recording stored on the agent.
+type Recording struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the recording.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The id of the recorded display.
+	Display uint32 `protobuf:"varint,2,opt,name=display,proto3" json:"display,omitempty"`
+	// The container format.
+	Format StartRecordingRequest_ContainerFormat `protobuf:"varint,3,opt,name=format,proto3,enum=StartRecordingRequest_ContainerFormat" json:"format,omitempty"`
+	// The state of the recording.
+	State Recording_RecordingState `protobuf:"varint,4,opt,name=state,proto3,enum=Recording_RecordingState" json:"state,omitempty"`
+	// The size of the file in bytes.
+	Size uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// The duration of the recording in milliseconds.
+	Duration uint32 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The error message associated with the failed state.
+	Error *string `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *Recording) Reset() {
+	*x = Recording{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x StreamDisplayRequest_FrameFormat) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Recording) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Deprecated: Use StreamDisplayRequest_FrameFormat.Descriptor instead.
-func (StreamDisplayRequest_FrameFormat) EnumDescriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{3, 0}
+func (*Recording) ProtoMessage() {}
+
+func (x *Recording) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ShellStartRequest_ShellType int32
+// Deprecated: Use Recording.ProtoReflect.Descriptor instead.
+func (*Recording) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{28}
+}
 
-const (
-	// Do not spawn a PTY
-	ShellStartRequest_RAW ShellStartRequest_ShellType = 0
-	// Spawn a PTY
-	ShellStartRequest_PTY ShellStartRequest_ShellType = 1
-)
+func (x *Recording) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
 
-// Enum value maps for ShellStartRequest_ShellType.
-var (
-	ShellStartRequest_ShellType_name = map[int32]string{
-		0: "RAW",
-		1: "PTY",
+func (x *Recording) GetDisplay() uint32 {
+	if x != nil {
+		return x.Display
 	}
-	ShellStartRequest_ShellType_value = map[string]int32{
-		"RAW": 0,
-		"PTY": 1,
+	return 0
+}
+
+func (x *Recording) GetFormat() StartRecordingRequest_ContainerFormat {
+	if x != nil {
+		return x.Format
 	}
-)
+	return StartRecordingRequest_WEBM
+}
 
-func (x ShellStartRequest_ShellType) Enum() *ShellStartRequest_ShellType {
-	p := new(ShellStartRequest_ShellType)
-	*p = x
-	return p
+func (x *Recording) GetState() Recording_RecordingState {
+	if x != nil {
+		return x.State
+	}
+	return Recording_RECORDING
 }
 
-func (x ShellStartRequest_ShellType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Recording) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-func (ShellStartRequest_ShellType) Descriptor() protoreflect.EnumDescriptor {
-	return file_agent_proto_enumTypes[2].Descriptor()
+func (x *Recording) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
 }
 
-func (ShellStartRequest_ShellType) Type() protoreflect.EnumType {
-	return &file_agent_proto_enumTypes[2]
+func (x *Recording) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
 }
 
-func (x ShellStartRequest_ShellType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Requests a recording is stopped.
+type StopRecordingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the recording.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-// Deprecated: Use ShellStartRequest_ShellType.Descriptor instead.
-func (ShellStartRequest_ShellType) EnumDescriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{9, 0}
+func (x *StopRecordingRequest) Reset() {
+	*x = StopRecordingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type ShellOutputResponse_ShellOutputChannel int32
+func (x *StopRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Stdout
-	ShellOutputResponse_OUT ShellOutputResponse_ShellOutputChannel = 0
-	// Stderr
-	ShellOutputResponse_ERR ShellOutputResponse_ShellOutputChannel = 1
-)
+func (*StopRecordingRequest) ProtoMessage() {}
 
-// Enum value maps for ShellOutputResponse_ShellOutputChannel.
-var (
-	ShellOutputResponse_ShellOutputChannel_name = map[int32]string{
-		0: "OUT",
-		1: "ERR",
+func (x *StopRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	ShellOutputResponse_ShellOutputChannel_value = map[string]int32{
-		"OUT": 0,
-		"ERR": 1,
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StopRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StopRecordingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-)
+	return 0
+}
 
-func (x ShellOutputResponse_ShellOutputChannel) Enum() *ShellOutputResponse_ShellOutputChannel {
-	p := new(ShellOutputResponse_ShellOutputChannel)
-	*p = x
-	return p
+// A recording listing response.
+type ListRecordingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recordings []*Recording `protobuf:"bytes,1,rep,name=recordings,proto3" json:"recordings,omitempty"`
 }
 
-func (x ShellOutputResponse_ShellOutputChannel) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *ListRecordingsResponse) Reset() {
+	*x = ListRecordingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (ShellOutputResponse_ShellOutputChannel) Descriptor() protoreflect.EnumDescriptor {
-	return file_agent_proto_enumTypes[3].Descriptor()
+func (x *ListRecordingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (ShellOutputResponse_ShellOutputChannel) Type() protoreflect.EnumType {
-	return &file_agent_proto_enumTypes[3]
+func (*ListRecordingsResponse) ProtoMessage() {}
+
+func (x *ListRecordingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x ShellOutputResponse_ShellOutputChannel) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use ListRecordingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecordingsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{30}
 }
 
-// Deprecated: Use ShellOutputResponse_ShellOutputChannel.Descriptor instead.
-func (ShellOutputResponse_ShellOutputChannel) EnumDescriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{13, 0}
+func (x *ListRecordingsResponse) GetRecordings() []*Recording {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
 }
 
-// AgentState describes the current state of the agent process and the virtual machine being controlled.
-type AgentState struct {
+// Requests a recording is downloaded.
+type DownloadRecordingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The state of the emulator.
-	EmulatorState AgentState_EmulatorState `protobuf:"varint,1,opt,name=emulator_state,json=emulatorState,proto3,enum=AgentState_EmulatorState" json:"emulator_state,omitempty"`
-	// The error message associated with the error state.
-	EmulatorError *string `protobuf:"bytes,2,opt,name=emulator_error,json=emulatorError,proto3,oneof" json:"emulator_error,omitempty"`
+	// The id of the recording.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *AgentState) Reset() {
-	*x = AgentState{}
+func (x *DownloadRecordingRequest) Reset() {
+	*x = DownloadRecordingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[0]
+		mi := &file_agent_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AgentState) String() string {
+func (x *DownloadRecordingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentState) ProtoMessage() {}
+func (*DownloadRecordingRequest) ProtoMessage() {}
 
-func (x *AgentState) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[0]
+func (x *DownloadRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,66 +3385,47 @@ func (x *AgentState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentState.ProtoReflect.Descriptor instead.
-func (*AgentState) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DownloadRecordingRequest.ProtoReflect.Descriptor instead.
+func (*DownloadRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AgentState) GetEmulatorState() AgentState_EmulatorState {
+func (x *DownloadRecordingRequest) GetId() uint64 {
 	if x != nil {
-		return x.EmulatorState
-	}
-	return AgentState_ERROR
-}
-
-func (x *AgentState) GetEmulatorError() string {
-	if x != nil && x.EmulatorError != nil {
-		return *x.EmulatorError
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-// Requests the emulator boots with the given configuration.
-// Example Settings:
-//
-//	Ram: 2048 Cores: 1
-//	Display 720x1280 320dpi
-type StartEmulatorRequest struct {
+// A recording download response.
+type DownloadRecordingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Memory in MBs
-	RamSize uint32 `protobuf:"varint,1,opt,name=ram_size,json=ramSize,proto3" json:"ram_size,omitempty"`
-	// Number of cores.
-	CoreCount uint32 `protobuf:"varint,2,opt,name=core_count,json=coreCount,proto3" json:"core_count,omitempty"`
-	// The DPI of the main display.
-	LcdDensity uint32 `protobuf:"varint,3,opt,name=lcd_density,json=lcdDensity,proto3" json:"lcd_density,omitempty"`
-	// The width of the main display.
-	LcdWidth uint32 `protobuf:"varint,4,opt,name=lcd_width,json=lcdWidth,proto3" json:"lcd_width,omitempty"`
-	// The height of the main display.
-	LcdHeight uint32 `protobuf:"varint,5,opt,name=lcd_height,json=lcdHeight,proto3" json:"lcd_height,omitempty"`
-	// Whether to root the adb session on start
-	RootAdb bool `protobuf:"varint,6,opt,name=root_adb,json=rootAdb,proto3" json:"root_adb,omitempty"`
+	// Raw bytes from the file.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Whether the end of the file has been reached.
+	Last bool `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
 }
 
-func (x *StartEmulatorRequest) Reset() {
-	*x = StartEmulatorRequest{}
+func (x *DownloadRecordingResponse) Reset() {
+	*x = DownloadRecordingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[1]
+		mi := &file_agent_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartEmulatorRequest) String() string {
+func (x *DownloadRecordingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartEmulatorRequest) ProtoMessage() {}
+func (*DownloadRecordingResponse) ProtoMessage() {}
 
-func (x *StartEmulatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[1]
+func (x *DownloadRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,80 +3436,102 @@ func (x *StartEmulatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartEmulatorRequest.ProtoReflect.Descriptor instead.
-func (*StartEmulatorRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DownloadRecordingResponse.ProtoReflect.Descriptor instead.
+func (*DownloadRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *StartEmulatorRequest) GetRamSize() uint32 {
+func (x *DownloadRecordingResponse) GetData() []byte {
 	if x != nil {
-		return x.RamSize
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *StartEmulatorRequest) GetCoreCount() uint32 {
+func (x *DownloadRecordingResponse) GetLast() bool {
 	if x != nil {
-		return x.CoreCount
+		return x.Last
 	}
-	return 0
+	return false
+}
+
+// Requests a recording is deleted.
+type DeleteRecordingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the recording.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *StartEmulatorRequest) GetLcdDensity() uint32 {
-	if x != nil {
-		return x.LcdDensity
+func (x *DeleteRecordingRequest) Reset() {
+	*x = DeleteRecordingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *StartEmulatorRequest) GetLcdWidth() uint32 {
-	if x != nil {
-		return x.LcdWidth
-	}
-	return 0
+func (x *DeleteRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StartEmulatorRequest) GetLcdHeight() uint32 {
-	if x != nil {
-		return x.LcdHeight
+func (*DeleteRecordingRequest) ProtoMessage() {}
+
+func (x *DeleteRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StartEmulatorRequest) GetRootAdb() bool {
+// Deprecated: Use DeleteRecordingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteRecordingRequest) GetId() uint64 {
 	if x != nil {
-		return x.RootAdb
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-// Requests the emulator exits.
-type StopEmulatorRequest struct {
+type StreamSysLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Kills the emulator without waiting.
-	ForceExit bool `protobuf:"varint,1,opt,name=force_exit,json=forceExit,proto3" json:"force_exit,omitempty"`
+	// Whether to first stream the retained recent entries.
+	Replay bool `protobuf:"varint,1,opt,name=replay,proto3" json:"replay,omitempty"`
+	// A regular expression (RE2 syntax) lines must match to be streamed. Leave empty to stream all lines.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *StopEmulatorRequest) Reset() {
-	*x = StopEmulatorRequest{}
+func (x *StreamSysLogRequest) Reset() {
+	*x = StreamSysLogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[2]
+		mi := &file_agent_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopEmulatorRequest) String() string {
+func (x *StreamSysLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopEmulatorRequest) ProtoMessage() {}
+func (*StreamSysLogRequest) ProtoMessage() {}
 
-func (x *StopEmulatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[2]
+func (x *StreamSysLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,52 +3542,62 @@ func (x *StopEmulatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopEmulatorRequest.ProtoReflect.Descriptor instead.
-func (*StopEmulatorRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use StreamSysLogRequest.ProtoReflect.Descriptor instead.
+func (*StreamSysLogRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *StopEmulatorRequest) GetForceExit() bool {
+func (x *StreamSysLogRequest) GetReplay() bool {
 	if x != nil {
-		return x.ForceExit
+		return x.Replay
 	}
 	return false
 }
 
-// Requests the display is streamed with the given configuration.
-type StreamDisplayRequest struct {
+func (x *StreamSysLogRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// A single line in the syslog.
+type SysLogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The frame encoding format.
-	Format StreamDisplayRequest_FrameFormat `protobuf:"varint,1,opt,name=format,proto3,enum=StreamDisplayRequest_FrameFormat" json:"format,omitempty"`
-	// The maximum number of frames to encode per second.
-	// Extra frames will be dropped, with the most recent frame encoded every 1/max_fps seconds.
-	// Set to 0 to disable limit.
-	MaxFps uint32 `protobuf:"varint,2,opt,name=max_fps,json=maxFps,proto3" json:"max_fps,omitempty"`
-	// How often in milliseconds to encode a keyframe.
-	// Set to 0 to only send when required. Not all formats use intermediate frames.
-	KeyframeInterval uint32 `protobuf:"varint,3,opt,name=keyframe_interval,json=keyframeInterval,proto3" json:"keyframe_interval,omitempty"`
+	// The raw line.
+	Line string `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	// The time the line was received, in milliseconds since the unix epoch.
+	Time uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// The time in seconds since the kernel booted, from the "[ seconds]" prefix of the line.
+	KernelTime *float64 `protobuf:"fixed64,3,opt,name=kernel_time,json=kernelTime,proto3,oneof" json:"kernel_time,omitempty"`
+	// The log level, from the "<level>" prefix of the line.
+	Level *SysLogEntry_Level `protobuf:"varint,4,opt,name=level,proto3,enum=SysLogEntry_Level,oneof" json:"level,omitempty"`
+	// The line without the level and time prefixes.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// The number of entries dropped from this stream so far, as the client fell behind.
+	Dropped uint64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
 }
 
-func (x *StreamDisplayRequest) Reset() {
-	*x = StreamDisplayRequest{}
+func (x *SysLogEntry) Reset() {
+	*x = SysLogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[3]
+		mi := &file_agent_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamDisplayRequest) String() string {
+func (x *SysLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamDisplayRequest) ProtoMessage() {}
+func (*SysLogEntry) ProtoMessage() {}
 
-func (x *StreamDisplayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[3]
+func (x *SysLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,69 +3608,81 @@ func (x *StreamDisplayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamDisplayRequest.ProtoReflect.Descriptor instead.
-func (*StreamDisplayRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SysLogEntry.ProtoReflect.Descriptor instead.
+func (*SysLogEntry) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *StreamDisplayRequest) GetFormat() StreamDisplayRequest_FrameFormat {
+func (x *SysLogEntry) GetLine() string {
 	if x != nil {
-		return x.Format
+		return x.Line
 	}
-	return StreamDisplayRequest_RGB888
+	return ""
 }
 
-func (x *StreamDisplayRequest) GetMaxFps() uint32 {
+func (x *SysLogEntry) GetTime() uint64 {
 	if x != nil {
-		return x.MaxFps
+		return x.Time
 	}
 	return 0
 }
 
-func (x *StreamDisplayRequest) GetKeyframeInterval() uint32 {
+func (x *SysLogEntry) GetKernelTime() float64 {
+	if x != nil && x.KernelTime != nil {
+		return *x.KernelTime
+	}
+	return 0
+}
+
+func (x *SysLogEntry) GetLevel() SysLogEntry_Level {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return SysLogEntry_EMERGENCY
+}
+
+func (x *SysLogEntry) GetMessage() string {
 	if x != nil {
-		return x.KeyframeInterval
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SysLogEntry) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
 	}
 	return 0
 }
 
-// A single display frame.
-// Based on the format requested, this may not be a keyframe.
-// A frame with zero width and height signifies the display is off. Clients should display a blank screen.
-// The width and height can change on a keyframe, which can occur if the emulator is restarted with a different
-// configuration.
-type DisplayFrame struct {
+type StreamEmulatorLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Whether this is a key frame. For some formats, this will always be true.
-	Keyframe bool `protobuf:"varint,1,opt,name=keyframe,proto3" json:"keyframe,omitempty"`
-	// The width of the frame.
-	Width uint32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
-	// The height of the frame.
-	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	// The raw frame data.
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// The minimum severity of entries to stream.
+	MinSeverity EmulatorLogEntry_Severity `protobuf:"varint,1,opt,name=min_severity,json=minSeverity,proto3,enum=EmulatorLogEntry_Severity" json:"min_severity,omitempty"`
+	// Whether to first stream the retained recent entries.
+	IncludeBacklog bool `protobuf:"varint,2,opt,name=include_backlog,json=includeBacklog,proto3" json:"include_backlog,omitempty"`
 }
 
-func (x *DisplayFrame) Reset() {
-	*x = DisplayFrame{}
+func (x *StreamEmulatorLogRequest) Reset() {
+	*x = StreamEmulatorLogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[4]
+		mi := &file_agent_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisplayFrame) String() string {
+func (x *StreamEmulatorLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisplayFrame) ProtoMessage() {}
+func (*StreamEmulatorLogRequest) ProtoMessage() {}
 
-func (x *DisplayFrame) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[4]
+func (x *StreamEmulatorLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,66 +3693,58 @@ func (x *DisplayFrame) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisplayFrame.ProtoReflect.Descriptor instead.
-func (*DisplayFrame) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *DisplayFrame) GetKeyframe() bool {
-	if x != nil {
-		return x.Keyframe
-	}
-	return false
-}
-
-func (x *DisplayFrame) GetWidth() uint32 {
-	if x != nil {
-		return x.Width
-	}
-	return 0
+// Deprecated: Use StreamEmulatorLogRequest.ProtoReflect.Descriptor instead.
+func (*StreamEmulatorLogRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DisplayFrame) GetHeight() uint32 {
+func (x *StreamEmulatorLogRequest) GetMinSeverity() EmulatorLogEntry_Severity {
 	if x != nil {
-		return x.Height
+		return x.MinSeverity
 	}
-	return 0
+	return EmulatorLogEntry_VERBOSE
 }
 
-func (x *DisplayFrame) GetData() []byte {
+func (x *StreamEmulatorLogRequest) GetIncludeBacklog() bool {
 	if x != nil {
-		return x.Data
+		return x.IncludeBacklog
 	}
-	return nil
+	return false
 }
 
-// A single line in the syslog.
-type SysLogEntry struct {
+// A single line of emulator output.
+type EmulatorLogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The raw line.
-	Line string `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	// The time the line was received, in milliseconds since the unix epoch.
+	Time uint64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The severity of the line. Lines without a severity inherit the severity of the previous line.
+	Severity EmulatorLogEntry_Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=EmulatorLogEntry_Severity" json:"severity,omitempty"`
+	// The stream the line was written to.
+	Stream EmulatorLogEntry_Stream `protobuf:"varint,3,opt,name=stream,proto3,enum=EmulatorLogEntry_Stream" json:"stream,omitempty"`
+	// The line, without the severity prefix.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SysLogEntry) Reset() {
-	*x = SysLogEntry{}
+func (x *EmulatorLogEntry) Reset() {
+	*x = EmulatorLogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[5]
+		mi := &file_agent_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SysLogEntry) String() string {
+func (x *EmulatorLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SysLogEntry) ProtoMessage() {}
+func (*EmulatorLogEntry) ProtoMessage() {}
 
-func (x *SysLogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[5]
+func (x *EmulatorLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,14 +3755,35 @@ func (x *SysLogEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SysLogEntry.ProtoReflect.Descriptor instead.
-func (*SysLogEntry) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use EmulatorLogEntry.ProtoReflect.Descriptor instead.
+func (*EmulatorLogEntry) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SysLogEntry) GetLine() string {
+func (x *EmulatorLogEntry) GetTime() uint64 {
 	if x != nil {
-		return x.Line
+		return x.Time
+	}
+	return 0
+}
+
+func (x *EmulatorLogEntry) GetSeverity() EmulatorLogEntry_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return EmulatorLogEntry_VERBOSE
+}
+
+func (x *EmulatorLogEntry) GetStream() EmulatorLogEntry_Stream {
+	if x != nil {
+		return x.Stream
+	}
+	return EmulatorLogEntry_STDOUT
+}
+
+func (x *EmulatorLogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
@@ -634,13 +3797,14 @@ type InputRequest struct {
 	// Types that are assignable to Event:
 	//
 	//	*InputRequest_Touch
+	//	*InputRequest_Key
 	Event isInputRequest_Event `protobuf_oneof:"event"`
 }
 
 func (x *InputRequest) Reset() {
 	*x = InputRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[6]
+		mi := &file_agent_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -653,7 +3817,7 @@ func (x *InputRequest) String() string {
 func (*InputRequest) ProtoMessage() {}
 
 func (x *InputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[6]
+	mi := &file_agent_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +3830,7 @@ func (x *InputRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputRequest.ProtoReflect.Descriptor instead.
 func (*InputRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{6}
+	return file_agent_proto_rawDescGZIP(), []int{38}
 }
 
 func (m *InputRequest) GetEvent() isInputRequest_Event {
@@ -683,6 +3847,13 @@ func (x *InputRequest) GetTouch() *TouchEvent {
 	return nil
 }
 
+func (x *InputRequest) GetKey() *KeyEvent {
+	if x, ok := x.GetEvent().(*InputRequest_Key); ok {
+		return x.Key
+	}
+	return nil
+}
+
 type isInputRequest_Event interface {
 	isInputRequest_Event()
 }
@@ -691,8 +3862,14 @@ type InputRequest_Touch struct {
 	Touch *TouchEvent `protobuf:"bytes,1,opt,name=touch,proto3,oneof"`
 }
 
+type InputRequest_Key struct {
+	Key *KeyEvent `protobuf:"bytes,2,opt,name=key,proto3,oneof"`
+}
+
 func (*InputRequest_Touch) isInputRequest_Event() {}
 
+func (*InputRequest_Key) isInputRequest_Event() {}
+
 // A touch event
 type TouchEvent struct {
 	state         protoimpl.MessageState
@@ -708,12 +3885,14 @@ type TouchEvent struct {
 	Pressure   uint32 `protobuf:"varint,4,opt,name=pressure,proto3" json:"pressure,omitempty"`
 	TouchMajor int32  `protobuf:"varint,5,opt,name=touch_major,json=touchMajor,proto3" json:"touch_major,omitempty"`
 	TouchMinor int32  `protobuf:"varint,6,opt,name=touch_minor,json=touchMinor,proto3" json:"touch_minor,omitempty"`
+	// The id of the display to touch. The main display has the id 0.
+	Display uint32 `protobuf:"varint,7,opt,name=display,proto3" json:"display,omitempty"`
 }
 
 func (x *TouchEvent) Reset() {
 	*x = TouchEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[7]
+		mi := &file_agent_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -726,7 +3905,7 @@ func (x *TouchEvent) String() string {
 func (*TouchEvent) ProtoMessage() {}
 
 func (x *TouchEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[7]
+	mi := &file_agent_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +3918,7 @@ func (x *TouchEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TouchEvent.ProtoReflect.Descriptor instead.
 func (*TouchEvent) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{7}
+	return file_agent_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *TouchEvent) GetIdentifier() uint32 {
@@ -784,6 +3963,71 @@ func (x *TouchEvent) GetTouchMinor() int32 {
 	return 0
 }
 
+func (x *TouchEvent) GetDisplay() uint32 {
+	if x != nil {
+		return x.Display
+	}
+	return 0
+}
+
+// A keyboard event
+type KeyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type KeyEvent_KeyEventType `protobuf:"varint,1,opt,name=type,proto3,enum=KeyEvent_KeyEventType" json:"type,omitempty"`
+	// The key value, following the w3c KeyboardEvent.key standard. For example "a", "Enter" or "ArrowLeft".
+	// The android specific keys "GoBack", "GoHome", "AppSwitch" and "Power" are also supported.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *KeyEvent) Reset() {
+	*x = KeyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyEvent) ProtoMessage() {}
+
+func (x *KeyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyEvent.ProtoReflect.Descriptor instead.
+func (*KeyEvent) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *KeyEvent) GetType() KeyEvent_KeyEventType {
+	if x != nil {
+		return x.Type
+	}
+	return KeyEvent_DOWN
+}
+
+func (x *KeyEvent) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 // An input message to the shell.
 type ShellRequest struct {
 	state         protoimpl.MessageState
@@ -801,7 +4045,7 @@ type ShellRequest struct {
 func (x *ShellRequest) Reset() {
 	*x = ShellRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[8]
+		mi := &file_agent_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -814,7 +4058,7 @@ func (x *ShellRequest) String() string {
 func (*ShellRequest) ProtoMessage() {}
 
 func (x *ShellRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[8]
+	mi := &file_agent_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +4071,7 @@ func (x *ShellRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShellRequest.ProtoReflect.Descriptor instead.
 func (*ShellRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{8}
+	return file_agent_proto_rawDescGZIP(), []int{41}
 }
 
 func (m *ShellRequest) GetMessage() isShellRequest_Message {
@@ -898,7 +4142,7 @@ type ShellStartRequest struct {
 func (x *ShellStartRequest) Reset() {
 	*x = ShellStartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[9]
+		mi := &file_agent_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -911,7 +4155,7 @@ func (x *ShellStartRequest) String() string {
 func (*ShellStartRequest) ProtoMessage() {}
 
 func (x *ShellStartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[9]
+	mi := &file_agent_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,7 +4168,7 @@ func (x *ShellStartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShellStartRequest.ProtoReflect.Descriptor instead.
 func (*ShellStartRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{9}
+	return file_agent_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ShellStartRequest) GetShellType() ShellStartRequest_ShellType {
@@ -963,7 +4207,7 @@ type ShellStdInRequest struct {
 func (x *ShellStdInRequest) Reset() {
 	*x = ShellStdInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[10]
+		mi := &file_agent_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -976,7 +4220,7 @@ func (x *ShellStdInRequest) String() string {
 func (*ShellStdInRequest) ProtoMessage() {}
 
 func (x *ShellStdInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[10]
+	mi := &file_agent_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,7 +4233,7 @@ func (x *ShellStdInRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShellStdInRequest.ProtoReflect.Descriptor instead.
 func (*ShellStdInRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{10}
+	return file_agent_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ShellStdInRequest) GetData() []byte {
@@ -1023,7 +4267,7 @@ type ShellResizeRequest struct {
 func (x *ShellResizeRequest) Reset() {
 	*x = ShellResizeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[11]
+		mi := &file_agent_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1036,7 +4280,7 @@ func (x *ShellResizeRequest) String() string {
 func (*ShellResizeRequest) ProtoMessage() {}
 
 func (x *ShellResizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[11]
+	mi := &file_agent_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +4293,7 @@ func (x *ShellResizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShellResizeRequest.ProtoReflect.Descriptor instead.
 func (*ShellResizeRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{11}
+	return file_agent_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ShellResizeRequest) GetRows() uint32 {
@@ -1096,7 +4340,7 @@ type ShellResponse struct {
 func (x *ShellResponse) Reset() {
 	*x = ShellResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[12]
+		mi := &file_agent_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1109,7 +4353,7 @@ func (x *ShellResponse) String() string {
 func (*ShellResponse) ProtoMessage() {}
 
 func (x *ShellResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[12]
+	mi := &file_agent_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,7 +4366,7 @@ func (x *ShellResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShellResponse.ProtoReflect.Descriptor instead.
 func (*ShellResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{12}
+	return file_agent_proto_rawDescGZIP(), []int{45}
 }
 
 func (m *ShellResponse) GetMessage() isShellResponse_Message {
@@ -1177,7 +4421,7 @@ type ShellOutputResponse struct {
 func (x *ShellOutputResponse) Reset() {
 	*x = ShellOutputResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[13]
+		mi := &file_agent_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1190,7 +4434,7 @@ func (x *ShellOutputResponse) String() string {
 func (*ShellOutputResponse) ProtoMessage() {}
 
 func (x *ShellOutputResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[13]
+	mi := &file_agent_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +4447,7 @@ func (x *ShellOutputResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShellOutputResponse.ProtoReflect.Descriptor instead.
 func (*ShellOutputResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{13}
+	return file_agent_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ShellOutputResponse) GetChannel() ShellOutputResponse_ShellOutputChannel {
@@ -1233,7 +4477,7 @@ type ShellExitResponse struct {
 func (x *ShellExitResponse) Reset() {
 	*x = ShellExitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[14]
+		mi := &file_agent_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1246,7 +4490,7 @@ func (x *ShellExitResponse) String() string {
 func (*ShellExitResponse) ProtoMessage() {}
 
 func (x *ShellExitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[14]
+	mi := &file_agent_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1259,7 +4503,7 @@ func (x *ShellExitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShellExitResponse.ProtoReflect.Descriptor instead.
 func (*ShellExitResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{14}
+	return file_agent_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ShellExitResponse) GetCode() uint32 {
@@ -1282,7 +4526,7 @@ type ListDirectoryRequest struct {
 func (x *ListDirectoryRequest) Reset() {
 	*x = ListDirectoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[15]
+		mi := &file_agent_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1295,7 +4539,7 @@ func (x *ListDirectoryRequest) String() string {
 func (*ListDirectoryRequest) ProtoMessage() {}
 
 func (x *ListDirectoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[15]
+	mi := &file_agent_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,7 +4552,7 @@ func (x *ListDirectoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDirectoryRequest.ProtoReflect.Descriptor instead.
 func (*ListDirectoryRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{15}
+	return file_agent_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ListDirectoryRequest) GetPath() string {
@@ -1330,7 +4574,7 @@ type ListDirectoryResponse struct {
 func (x *ListDirectoryResponse) Reset() {
 	*x = ListDirectoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[16]
+		mi := &file_agent_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1343,7 +4587,7 @@ func (x *ListDirectoryResponse) String() string {
 func (*ListDirectoryResponse) ProtoMessage() {}
 
 func (x *ListDirectoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[16]
+	mi := &file_agent_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1356,7 +4600,7 @@ func (x *ListDirectoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDirectoryResponse.ProtoReflect.Descriptor instead.
 func (*ListDirectoryResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{16}
+	return file_agent_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ListDirectoryResponse) GetEntries() []*ListDirectoryEntry {
@@ -1386,7 +4630,7 @@ type ListDirectoryEntry struct {
 func (x *ListDirectoryEntry) Reset() {
 	*x = ListDirectoryEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[17]
+		mi := &file_agent_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1399,7 +4643,7 @@ func (x *ListDirectoryEntry) String() string {
 func (*ListDirectoryEntry) ProtoMessage() {}
 
 func (x *ListDirectoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[17]
+	mi := &file_agent_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,7 +4656,7 @@ func (x *ListDirectoryEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDirectoryEntry.ProtoReflect.Descriptor instead.
 func (*ListDirectoryEntry) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{17}
+	return file_agent_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListDirectoryEntry) GetName() string {
@@ -1479,7 +4723,7 @@ type FileStat struct {
 func (x *FileStat) Reset() {
 	*x = FileStat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[18]
+		mi := &file_agent_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1492,7 +4736,7 @@ func (x *FileStat) String() string {
 func (*FileStat) ProtoMessage() {}
 
 func (x *FileStat) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[18]
+	mi := &file_agent_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1505,7 +4749,7 @@ func (x *FileStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileStat.ProtoReflect.Descriptor instead.
 func (*FileStat) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{18}
+	return file_agent_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *FileStat) GetDev() uint64 {
@@ -1593,7 +4837,7 @@ type StatFileRequest struct {
 func (x *StatFileRequest) Reset() {
 	*x = StatFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[19]
+		mi := &file_agent_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1606,7 +4850,7 @@ func (x *StatFileRequest) String() string {
 func (*StatFileRequest) ProtoMessage() {}
 
 func (x *StatFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[19]
+	mi := &file_agent_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,7 +4863,7 @@ func (x *StatFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatFileRequest.ProtoReflect.Descriptor instead.
 func (*StatFileRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{19}
+	return file_agent_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *StatFileRequest) GetPath() string {
@@ -1652,7 +4896,7 @@ type StatFileResponse struct {
 func (x *StatFileResponse) Reset() {
 	*x = StatFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[20]
+		mi := &file_agent_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1665,7 +4909,7 @@ func (x *StatFileResponse) String() string {
 func (*StatFileResponse) ProtoMessage() {}
 
 func (x *StatFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[20]
+	mi := &file_agent_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,7 +4922,7 @@ func (x *StatFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatFileResponse.ProtoReflect.Descriptor instead.
 func (*StatFileResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{20}
+	return file_agent_proto_rawDescGZIP(), []int{53}
 }
 
 func (m *StatFileResponse) GetStat() isStatFileResponse_Stat {
@@ -1731,7 +4975,7 @@ type PullFileRequest struct {
 func (x *PullFileRequest) Reset() {
 	*x = PullFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[21]
+		mi := &file_agent_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1744,7 +4988,7 @@ func (x *PullFileRequest) String() string {
 func (*PullFileRequest) ProtoMessage() {}
 
 func (x *PullFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[21]
+	mi := &file_agent_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,7 +5001,7 @@ func (x *PullFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullFileRequest.ProtoReflect.Descriptor instead.
 func (*PullFileRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{21}
+	return file_agent_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *PullFileRequest) GetPath() string {
@@ -1782,7 +5026,7 @@ type PullFileResponse struct {
 func (x *PullFileResponse) Reset() {
 	*x = PullFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[22]
+		mi := &file_agent_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1795,7 +5039,7 @@ func (x *PullFileResponse) String() string {
 func (*PullFileResponse) ProtoMessage() {}
 
 func (x *PullFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[22]
+	mi := &file_agent_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +5052,7 @@ func (x *PullFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullFileResponse.ProtoReflect.Descriptor instead.
 func (*PullFileResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{22}
+	return file_agent_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *PullFileResponse) GetData() []byte {
@@ -1842,7 +5086,7 @@ type PushFileRequest struct {
 func (x *PushFileRequest) Reset() {
 	*x = PushFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[23]
+		mi := &file_agent_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1855,7 +5099,7 @@ func (x *PushFileRequest) String() string {
 func (*PushFileRequest) ProtoMessage() {}
 
 func (x *PushFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[23]
+	mi := &file_agent_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,7 +5112,7 @@ func (x *PushFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushFileRequest.ProtoReflect.Descriptor instead.
 func (*PushFileRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{23}
+	return file_agent_proto_rawDescGZIP(), []int{56}
 }
 
 func (m *PushFileRequest) GetMessage() isPushFileRequest_Message {
@@ -1933,23 +5177,308 @@ type PushFileStartRequest struct {
 	Mode uint32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-func (x *PushFileStartRequest) Reset() {
-	*x = PushFileStartRequest{}
+func (x *PushFileStartRequest) Reset() {
+	*x = PushFileStartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFileStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFileStartRequest) ProtoMessage() {}
+
+func (x *PushFileStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFileStartRequest.ProtoReflect.Descriptor instead.
+func (*PushFileStartRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PushFileStartRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PushFileStartRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+// A file push data request.
+type PushFileDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data to write to the file.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PushFileDataRequest) Reset() {
+	*x = PushFileDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFileDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFileDataRequest) ProtoMessage() {}
+
+func (x *PushFileDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFileDataRequest.ProtoReflect.Descriptor instead.
+func (*PushFileDataRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PushFileDataRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A file push end request.
+type PushFileEndRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The modified time to set.
+	Mtime uint32 `protobuf:"varint,1,opt,name=mtime,proto3" json:"mtime,omitempty"`
+}
+
+func (x *PushFileEndRequest) Reset() {
+	*x = PushFileEndRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFileEndRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFileEndRequest) ProtoMessage() {}
+
+func (x *PushFileEndRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFileEndRequest.ProtoReflect.Descriptor instead.
+func (*PushFileEndRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PushFileEndRequest) GetMtime() uint32 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+// An image upload request.
+type UploadImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*UploadImageRequest_Start
+	//	*UploadImageRequest_Data
+	Message isUploadImageRequest_Message `protobuf_oneof:"message"`
+}
+
+func (x *UploadImageRequest) Reset() {
+	*x = UploadImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageRequest) ProtoMessage() {}
+
+func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{60}
+}
+
+func (m *UploadImageRequest) GetMessage() isUploadImageRequest_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *UploadImageRequest) GetStart() *UploadImageStartRequest {
+	if x, ok := x.GetMessage().(*UploadImageRequest_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *UploadImageRequest) GetData() *UploadImageDataRequest {
+	if x, ok := x.GetMessage().(*UploadImageRequest_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+type isUploadImageRequest_Message interface {
+	isUploadImageRequest_Message()
+}
+
+type UploadImageRequest_Start struct {
+	Start *UploadImageStartRequest `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type UploadImageRequest_Data struct {
+	Data *UploadImageDataRequest `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*UploadImageRequest_Start) isUploadImageRequest_Message() {}
+
+func (*UploadImageRequest_Data) isUploadImageRequest_Message() {}
+
+// An image upload start request.
+type UploadImageStartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name to store the image under.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UploadImageStartRequest) Reset() {
+	*x = UploadImageStartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadImageStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageStartRequest) ProtoMessage() {}
+
+func (x *UploadImageStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageStartRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageStartRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UploadImageStartRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// An image upload data request.
+type UploadImageDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data to append to the image.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UploadImageDataRequest) Reset() {
+	*x = UploadImageDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[24]
+		mi := &file_agent_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushFileStartRequest) String() string {
+func (x *UploadImageDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushFileStartRequest) ProtoMessage() {}
+func (*UploadImageDataRequest) ProtoMessage() {}
 
-func (x *PushFileStartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[24]
+func (x *UploadImageDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1960,52 +5489,47 @@ func (x *PushFileStartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushFileStartRequest.ProtoReflect.Descriptor instead.
-func (*PushFileStartRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *PushFileStartRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use UploadImageDataRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageDataRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *PushFileStartRequest) GetMode() uint32 {
+func (x *UploadImageDataRequest) GetData() []byte {
 	if x != nil {
-		return x.Mode
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// A file push data request.
-type PushFileDataRequest struct {
+// An uploaded image.
+type UploadedImage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data to write to the file.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// The name of the image.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The size of the image in bytes.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *PushFileDataRequest) Reset() {
-	*x = PushFileDataRequest{}
+func (x *UploadedImage) Reset() {
+	*x = UploadedImage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[25]
+		mi := &file_agent_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushFileDataRequest) String() string {
+func (x *UploadedImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushFileDataRequest) ProtoMessage() {}
+func (*UploadedImage) ProtoMessage() {}
 
-func (x *PushFileDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[25]
+func (x *UploadedImage) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2016,45 +5540,52 @@ func (x *PushFileDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushFileDataRequest.ProtoReflect.Descriptor instead.
-func (*PushFileDataRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use UploadedImage.ProtoReflect.Descriptor instead.
+func (*UploadedImage) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *PushFileDataRequest) GetData() []byte {
+func (x *UploadedImage) GetName() string {
 	if x != nil {
-		return x.Data
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// A file push end request.
-type PushFileEndRequest struct {
+func (x *UploadedImage) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// Requests an uploaded image is deleted.
+type DeleteImageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The modified time to set.
-	Mtime uint32 `protobuf:"varint,1,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	// The name of the image.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PushFileEndRequest) Reset() {
-	*x = PushFileEndRequest{}
+func (x *DeleteImageRequest) Reset() {
+	*x = DeleteImageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[26]
+		mi := &file_agent_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushFileEndRequest) String() string {
+func (x *DeleteImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushFileEndRequest) ProtoMessage() {}
+func (*DeleteImageRequest) ProtoMessage() {}
 
-func (x *PushFileEndRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[26]
+func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,16 +5596,16 @@ func (x *PushFileEndRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushFileEndRequest.ProtoReflect.Descriptor instead.
-func (*PushFileEndRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DeleteImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImageRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *PushFileEndRequest) GetMtime() uint32 {
+func (x *DeleteImageRequest) GetName() string {
 	if x != nil {
-		return x.Mtime
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
 var File_agent_proto protoreflect.FileDescriptor
@@ -2082,7 +5613,7 @@ var File_agent_proto protoreflect.FileDescriptor
 var file_agent_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x01, 0x0a, 0x0a, 0x41,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x08, 0x0a, 0x0a, 0x41,
 	0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x65, 0x6d, 0x75,
 	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x19, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x45,
@@ -2090,223 +5621,721 @@ var file_agent_proto_rawDesc = []byte{
 	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x65,
 	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x22, 0x3e, 0x0a, 0x0d, 0x45, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55,
-	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc8, 0x01, 0x0a, 0x14, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x63, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x63, 0x64, 0x44, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x12, 0x1b,
-	0x0a, 0x09, 0x6c, 0x63, 0x64, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x6c, 0x63, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
-	0x63, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x6c, 0x63, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
-	0x6f, 0x74, 0x5f, 0x61, 0x64, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x6f,
-	0x6f, 0x74, 0x41, 0x64, 0x62, 0x22, 0x34, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6d, 0x75,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x14,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x61, 0x6d,
-	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x66,
-	0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x10, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x22, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x47, 0x42, 0x38, 0x38, 0x38, 0x10, 0x00,
-	0x12, 0x07, 0x0a, 0x03, 0x56, 0x50, 0x38, 0x10, 0x01, 0x22, 0x6c, 0x0a, 0x0c, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79,
-	0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79,
-	0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x21, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x4c, 0x6f,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f,
-	0x75, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x6f, 0x75, 0x63,
-	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x42,
-	0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x75,
-	0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x01, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x4d, 0x61, 0x6a, 0x6f, 0x72,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x6f,
-	0x72, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2a,
-	0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x64, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x53, 0x68, 0x65,
-	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x68,
-	0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
-	0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x73, 0x68,
-	0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x74, 0x65, 0x72,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x22, 0x1d, 0x0a, 0x09, 0x53, 0x68, 0x65, 0x6c,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x00, 0x12, 0x07,
-	0x0a, 0x03, 0x50, 0x54, 0x59, 0x10, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0x3d, 0x0a, 0x11, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x64, 0x49, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65,
-	0x22, 0x6a, 0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77,
-	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x74, 0x0a, 0x0d,
-	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x28, 0x0a,
-	0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x53, 0x68,
-	0x65, 0x6c, 0x6c, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x00, 0x52, 0x04, 0x65, 0x78, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x53, 0x68,
-	0x65, 0x6c, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x26, 0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x55, 0x54, 0x10, 0x00,
-	0x12, 0x07, 0x0a, 0x03, 0x45, 0x52, 0x52, 0x10, 0x01, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x68, 0x65,
-	0x6c, 0x6c, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x46,
-	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a,
+	0x0d, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26,
+	0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x10, 0x65, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x0f, 0x65, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x0c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x62, 0x6f,
+	0x6f, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52,
+	0x08, 0x62, 0x6f, 0x6f, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x70,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x61, 0x64, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x09, 0x61, 0x64, 0x62, 0x53,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x62, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x64, 0x62, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x45, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x48, 0x07, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x0c, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46,
+	0x61, 0x74, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x66, 0x61, 0x74, 0x61, 0x6c,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x0d, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x22, 0x64, 0x0a,
+	0x09, 0x42, 0x6f, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f,
+	0x54, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x45,
+	0x52, 0x4e, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x5a, 0x59, 0x47, 0x4f, 0x54, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x04, 0x12,
+	0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x05, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61,
+	0x64, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xd1, 0x01, 0x0a,
+	0x0a, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x46, 0x61, 0x74, 0x61,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x22, 0x55, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x45, 0x52, 0x4e, 0x45, 0x4c, 0x5f, 0x50, 0x41, 0x4e, 0x49,
+	0x43, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x41, 0x54, 0x43, 0x48, 0x44, 0x4f,
+	0x47, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x5a, 0x59, 0x47,
+	0x4f, 0x54, 0x45, 0x5f, 0x43, 0x52, 0x41, 0x53, 0x48, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x10, 0x03,
+	0x22, 0x3f, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0x4f, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x42, 0x6f,
+	0x6f, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0xcc, 0x0c, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x61, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72,
+	0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x72, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x63, 0x64, 0x5f, 0x64, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x63, 0x64, 0x44,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x63, 0x64, 0x5f, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x63, 0x64, 0x57, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x63, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x63, 0x64, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x61, 0x64, 0x62, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x64, 0x62, 0x12, 0x40, 0x0a,
+	0x12, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x61, 0x72, 0x79, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x11, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x61,
+	0x74, 0x61, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x64, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x64, 0x63, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x3d, 0x0a, 0x08, 0x67, 0x70, 0x75, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x70, 0x75, 0x4d, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x67, 0x70, 0x75, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x3d, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6d, 0x65,
+	0x72, 0x61, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x3f,
+	0x0a, 0x0c, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6d, 0x65,
+	0x72, 0x61, 0x52, 0x0b, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x01, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x4d, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x43, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x72, 0x69, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x65, 0x61, 0x70, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x5e, 0x0a, 0x13, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x68,
+	0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x38, 0x0a, 0x0f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x0e, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x65, 0x70, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x45,
+	0x0a, 0x17, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a, 0x07, 0x47, 0x70, 0x75, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x53, 0x57, 0x49, 0x46, 0x54, 0x53, 0x48, 0x41,
+	0x44, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x47, 0x50, 0x55, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x47, 0x50, 0x55, 0x5f, 0x47, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x47,
+	0x50, 0x55, 0x5f, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10,
+	0x04, 0x22, 0x48, 0x0a, 0x06, 0x43, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x12, 0x13, 0x0a, 0x0f, 0x43,
+	0x41, 0x4d, 0x45, 0x52, 0x41, 0x5f, 0x45, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4d, 0x45, 0x52, 0x41, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x4d, 0x45, 0x52, 0x41, 0x5f, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x45, 0x4e, 0x45, 0x10, 0x02, 0x22, 0x8d, 0x01, 0x0a, 0x0e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x10,
+	0x0a, 0x0c, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4c, 0x54, 0x45, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x48, 0x53, 0x44,
+	0x50, 0x41, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f,
+	0x55, 0x4d, 0x54, 0x53, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
+	0x4b, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x5f, 0x47, 0x50, 0x52, 0x53, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x45,
+	0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x47, 0x53, 0x4d, 0x10, 0x06, 0x22, 0x2a, 0x0a, 0x0b, 0x4f,
+	0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f,
+	0x52, 0x54, 0x52, 0x41, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x41, 0x4e, 0x44,
+	0x53, 0x43, 0x41, 0x50, 0x45, 0x10, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x70, 0x75, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x22, 0xc3, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x22, 0x2d, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4f,
+	0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x02, 0x22, 0xd5, 0x01, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x6b, 0x65,
+	0x72, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x65,
+	0x72, 0x6e, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x61, 0x6d, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x72, 0x61, 0x6d, 0x64, 0x69, 0x73, 0x6b, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6b, 0x65,
+	0x72, 0x6e, 0x65, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
+	0x61, 0x6d, 0x64, 0x69, 0x73, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xa3, 0x01, 0x0a, 0x07, 0x56, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x56, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x6f, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x56, 0x4d, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41,
+	0x55, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x41, 0x56, 0x45, 0x5f, 0x56,
+	0x4d, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10,
+	0x05, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x06, 0x22, 0x40, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70,
+	0x69, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61,
+	0x70, 0x69, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x62, 0x69, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x29, 0x0a, 0x13, 0x53,
+	0x61, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x2b, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2b,
+	0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x16, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2b, 0x0a, 0x15, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x70, 0x0a, 0x10, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x3c, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x22, 0x77, 0x0a, 0x07, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x11, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x22, 0x0a, 0x0b,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x50,
+	0x4e, 0x47, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x47, 0x42, 0x38, 0x38, 0x38, 0x10, 0x01,
+	0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x4e, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x5f, 0x65, 0x78, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x22, 0xdf, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x70, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x22, 0x2c, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x47, 0x42, 0x38, 0x38, 0x38, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x56, 0x50, 0x38, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x32, 0x36, 0x34,
+	0x10, 0x02, 0x22, 0x89, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x35,
+	0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x5f, 0x66, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x46,
+	0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x74, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x54, 0x6f,
+	0x75, 0x63, 0x68, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x42, 0x4d,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x50, 0x34, 0x10, 0x01, 0x22, 0xb6, 0x02, 0x0a, 0x09,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x22,
+	0x39, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x26, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43,
+	0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x61, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a,
+	0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0xc2, 0x02, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0b,
+	0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x0a, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x53, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0d,
+	0x0a, 0x09, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54,
+	0x49, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x4e, 0x4f, 0x54, 0x49, 0x43, 0x45, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e,
+	0x46, 0x4f, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x07, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x45,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x22, 0x9d,
+	0x02, 0x0a, 0x10, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x45, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4f, 0x0a, 0x08, 0x53,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x45, 0x52, 0x42, 0x4f,
+	0x53, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x04, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x05, 0x22, 0x20, 0x0a, 0x06,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x4f, 0x55, 0x54,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x45, 0x52, 0x52, 0x10, 0x01, 0x22, 0x5b,
+	0x0a, 0x0c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x05, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x54, 0x6f, 0x75, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f,
+	0x75, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x0a,
+	0x54, 0x6f, 0x75, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x01, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75,
+	0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x5f, 0x6d, 0x61, 0x6a, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x4d, 0x61,
+	0x6a, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x5f, 0x6d, 0x69, 0x6e,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x4d,
+	0x69, 0x6e, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x75,
+	0x0a, 0x08, 0x4b, 0x65, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2b, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x52,
+	0x45, 0x53, 0x53, 0x10, 0x02, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x64, 0x49, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x2d,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x09, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x53, 0x68, 0x65,
+	0x6c, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x0a, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x65,
+	0x72, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x08, 0x74, 0x65, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x22, 0x1d, 0x0a, 0x09,
+	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x54, 0x59, 0x10, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x65, 0x72, 0x6d,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3d, 0x0a, 0x11, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x74,
+	0x64, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f,
+	0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x74, 0x0a, 0x0d, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x28, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x4f,
+	0x55, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x52, 0x52, 0x10, 0x01, 0x22, 0x27, 0x0a,
+	0x11, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0x46, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x08, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x76, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x64, 0x65, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6e, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x69, 0x6e, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x67, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x48,
+	0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x67, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x74, 0x61,
+	0x74, 0x22, 0x25, 0x0a, 0x0f, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6c, 0x61, 0x73, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x42, 0x09, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x46,
+	0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x2a, 0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x80,
+	0x01, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x2d, 0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06, 0x0a,
-	0x04, 0x73, 0x74, 0x61, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x64, 0x65, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x03, 0x69, 0x6e, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x67, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d,
-	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x0f, 0x53, 0x74,
-	0x61, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c,
-	0x69, 0x6e, 0x6b, 0x73, 0x22, 0x67, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
-	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x74, 0x22, 0x25, 0x0a,
-	0x0f, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x6c, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74,
-	0x22, 0xa0, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x27, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x50,
-	0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x44,
+	0x22, 0x2c, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44,
 	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2a,
-	0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x32, 0xf6, 0x04, 0x0a, 0x0f, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x34,
-	0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x15, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6d, 0x75,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37,
+	0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x32, 0xc7, 0x0f, 0x0a, 0x0f, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x15, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x70,
+	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x70, 0x45, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x0a,
+	0x67, 0x65, 0x74, 0x56, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x70,
+	0x74, 0x79, 0x1a, 0x08, 0x2e, 0x56, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x10,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0c, 0x73, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x14, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x0e, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x28,
+	0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x0d, 0x67, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x12, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x70,
 	0x6c, 0x61, 0x79, 0x12, 0x15, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x70,
 	0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x30, 0x01, 0x12, 0x36, 0x0a, 0x0c, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x70, 0x6c, 0x61, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x34, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x16, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x15, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0e, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x53, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x0d, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x11,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x19, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34,
+	0x0a, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x12, 0x14,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x53, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x19, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x4c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0d, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x0d, 0x2e, 0x53, 0x68, 0x65,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x53, 0x68, 0x65, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e, 0x0a,
+	0x0d, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x08, 0x70, 0x75, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x50, 0x75, 0x6c,
+	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x50,
+	0x75, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x53,
-	0x68, 0x65, 0x6c, 0x6c, 0x12, 0x0d, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x75, 0x6c, 0x6c,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x70,
-	0x75, 0x73, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x28, 0x01, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x34, 0x0a, 0x0b, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x13, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x28, 0x01, 0x12,
+	0x3a, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x13,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x0c, 0x5a, 0x0a, 0x2e,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2321,86 +6350,219 @@ func file_agent_proto_rawDescGZIP() []byte {
 	return file_agent_proto_rawDescData
 }
 
-var file_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 19)
+var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_agent_proto_goTypes = []interface{}{
 	(AgentState_EmulatorState)(0),               // 0: AgentState.EmulatorState
-	(StreamDisplayRequest_FrameFormat)(0),       // 1: StreamDisplayRequest.FrameFormat
-	(ShellStartRequest_ShellType)(0),            // 2: ShellStartRequest.ShellType
-	(ShellOutputResponse_ShellOutputChannel)(0), // 3: ShellOutputResponse.ShellOutputChannel
-	(*AgentState)(nil),                          // 4: AgentState
-	(*StartEmulatorRequest)(nil),                // 5: StartEmulatorRequest
-	(*StopEmulatorRequest)(nil),                 // 6: StopEmulatorRequest
-	(*StreamDisplayRequest)(nil),                // 7: StreamDisplayRequest
-	(*DisplayFrame)(nil),                        // 8: DisplayFrame
-	(*SysLogEntry)(nil),                         // 9: SysLogEntry
-	(*InputRequest)(nil),                        // 10: InputRequest
-	(*TouchEvent)(nil),                          // 11: TouchEvent
-	(*ShellRequest)(nil),                        // 12: ShellRequest
-	(*ShellStartRequest)(nil),                   // 13: ShellStartRequest
-	(*ShellStdInRequest)(nil),                   // 14: ShellStdInRequest
-	(*ShellResizeRequest)(nil),                  // 15: ShellResizeRequest
-	(*ShellResponse)(nil),                       // 16: ShellResponse
-	(*ShellOutputResponse)(nil),                 // 17: ShellOutputResponse
-	(*ShellExitResponse)(nil),                   // 18: ShellExitResponse
-	(*ListDirectoryRequest)(nil),                // 19: ListDirectoryRequest
-	(*ListDirectoryResponse)(nil),               // 20: ListDirectoryResponse
-	(*ListDirectoryEntry)(nil),                  // 21: ListDirectoryEntry
-	(*FileStat)(nil),                            // 22: FileStat
-	(*StatFileRequest)(nil),                     // 23: StatFileRequest
-	(*StatFileResponse)(nil),                    // 24: StatFileResponse
-	(*PullFileRequest)(nil),                     // 25: PullFileRequest
-	(*PullFileResponse)(nil),                    // 26: PullFileResponse
-	(*PushFileRequest)(nil),                     // 27: PushFileRequest
-	(*PushFileStartRequest)(nil),                // 28: PushFileStartRequest
-	(*PushFileDataRequest)(nil),                 // 29: PushFileDataRequest
-	(*PushFileEndRequest)(nil),                  // 30: PushFileEndRequest
-	(*empty.Empty)(nil),                         // 31: google.protobuf.Empty
+	(AgentState_BootStage)(0),                   // 1: AgentState.BootStage
+	(FatalEvent_Type)(0),                        // 2: FatalEvent.Type
+	(StartEmulatorRequest_GpuMode)(0),           // 3: StartEmulatorRequest.GpuMode
+	(StartEmulatorRequest_Camera)(0),            // 4: StartEmulatorRequest.Camera
+	(StartEmulatorRequest_NetworkProfile)(0),    // 5: StartEmulatorRequest.NetworkProfile
+	(StartEmulatorRequest_Orientation)(0),       // 6: StartEmulatorRequest.Orientation
+	(RestartPolicy_Mode)(0),                     // 7: RestartPolicy.Mode
+	(VmState_RunState)(0),                       // 8: VmState.RunState
+	(ScreenshotRequest_ImageFormat)(0),          // 9: ScreenshotRequest.ImageFormat
+	(StreamDisplayRequest_FrameFormat)(0),       // 10: StreamDisplayRequest.FrameFormat
+	(StartRecordingRequest_ContainerFormat)(0),  // 11: StartRecordingRequest.ContainerFormat
+	(Recording_RecordingState)(0),               // 12: Recording.RecordingState
+	(SysLogEntry_Level)(0),                      // 13: SysLogEntry.Level
+	(EmulatorLogEntry_Severity)(0),              // 14: EmulatorLogEntry.Severity
+	(EmulatorLogEntry_Stream)(0),                // 15: EmulatorLogEntry.Stream
+	(KeyEvent_KeyEventType)(0),                  // 16: KeyEvent.KeyEventType
+	(ShellStartRequest_ShellType)(0),            // 17: ShellStartRequest.ShellType
+	(ShellOutputResponse_ShellOutputChannel)(0), // 18: ShellOutputResponse.ShellOutputChannel
+	(*AgentState)(nil),                          // 19: AgentState
+	(*FatalEvent)(nil),                          // 20: FatalEvent
+	(*StreamStateRequest)(nil),                  // 21: StreamStateRequest
+	(*BootProgress)(nil),                        // 22: BootProgress
+	(*StartEmulatorRequest)(nil),                // 23: StartEmulatorRequest
+	(*RestartPolicy)(nil),                       // 24: RestartPolicy
+	(*ImageOverrides)(nil),                      // 25: ImageOverrides
+	(*VmState)(nil),                             // 26: VmState
+	(*ListSystemImagesResponse)(nil),            // 27: ListSystemImagesResponse
+	(*SystemImage)(nil),                         // 28: SystemImage
+	(*SaveSnapshotRequest)(nil),                 // 29: SaveSnapshotRequest
+	(*LoadSnapshotRequest)(nil),                 // 30: LoadSnapshotRequest
+	(*DeleteSnapshotRequest)(nil),               // 31: DeleteSnapshotRequest
+	(*ExportSnapshotRequest)(nil),               // 32: ExportSnapshotRequest
+	(*ExportSnapshotResponse)(nil),              // 33: ExportSnapshotResponse
+	(*ImportSnapshotRequest)(nil),               // 34: ImportSnapshotRequest
+	(*ListSnapshotsResponse)(nil),               // 35: ListSnapshotsResponse
+	(*Snapshot)(nil),                            // 36: Snapshot
+	(*SecondaryDisplay)(nil),                    // 37: SecondaryDisplay
+	(*ListDisplaysResponse)(nil),                // 38: ListDisplaysResponse
+	(*Display)(nil),                             // 39: Display
+	(*ScreenshotRequest)(nil),                   // 40: ScreenshotRequest
+	(*Screenshot)(nil),                          // 41: Screenshot
+	(*StopEmulatorRequest)(nil),                 // 42: StopEmulatorRequest
+	(*StreamDisplayRequest)(nil),                // 43: StreamDisplayRequest
+	(*DisplayFrame)(nil),                        // 44: DisplayFrame
+	(*RequestKeyframeRequest)(nil),              // 45: RequestKeyframeRequest
+	(*StartRecordingRequest)(nil),               // 46: StartRecordingRequest
+	(*Recording)(nil),                           // 47: Recording
+	(*StopRecordingRequest)(nil),                // 48: StopRecordingRequest
+	(*ListRecordingsResponse)(nil),              // 49: ListRecordingsResponse
+	(*DownloadRecordingRequest)(nil),            // 50: DownloadRecordingRequest
+	(*DownloadRecordingResponse)(nil),           // 51: DownloadRecordingResponse
+	(*DeleteRecordingRequest)(nil),              // 52: DeleteRecordingRequest
+	(*StreamSysLogRequest)(nil),                 // 53: StreamSysLogRequest
+	(*SysLogEntry)(nil),                         // 54: SysLogEntry
+	(*StreamEmulatorLogRequest)(nil),            // 55: StreamEmulatorLogRequest
+	(*EmulatorLogEntry)(nil),                    // 56: EmulatorLogEntry
+	(*InputRequest)(nil),                        // 57: InputRequest
+	(*TouchEvent)(nil),                          // 58: TouchEvent
+	(*KeyEvent)(nil),                            // 59: KeyEvent
+	(*ShellRequest)(nil),                        // 60: ShellRequest
+	(*ShellStartRequest)(nil),                   // 61: ShellStartRequest
+	(*ShellStdInRequest)(nil),                   // 62: ShellStdInRequest
+	(*ShellResizeRequest)(nil),                  // 63: ShellResizeRequest
+	(*ShellResponse)(nil),                       // 64: ShellResponse
+	(*ShellOutputResponse)(nil),                 // 65: ShellOutputResponse
+	(*ShellExitResponse)(nil),                   // 66: ShellExitResponse
+	(*ListDirectoryRequest)(nil),                // 67: ListDirectoryRequest
+	(*ListDirectoryResponse)(nil),               // 68: ListDirectoryResponse
+	(*ListDirectoryEntry)(nil),                  // 69: ListDirectoryEntry
+	(*FileStat)(nil),                            // 70: FileStat
+	(*StatFileRequest)(nil),                     // 71: StatFileRequest
+	(*StatFileResponse)(nil),                    // 72: StatFileResponse
+	(*PullFileRequest)(nil),                     // 73: PullFileRequest
+	(*PullFileResponse)(nil),                    // 74: PullFileResponse
+	(*PushFileRequest)(nil),                     // 75: PushFileRequest
+	(*PushFileStartRequest)(nil),                // 76: PushFileStartRequest
+	(*PushFileDataRequest)(nil),                 // 77: PushFileDataRequest
+	(*PushFileEndRequest)(nil),                  // 78: PushFileEndRequest
+	(*UploadImageRequest)(nil),                  // 79: UploadImageRequest
+	(*UploadImageStartRequest)(nil),             // 80: UploadImageStartRequest
+	(*UploadImageDataRequest)(nil),              // 81: UploadImageDataRequest
+	(*UploadedImage)(nil),                       // 82: UploadedImage
+	(*DeleteImageRequest)(nil),                  // 83: DeleteImageRequest
+	nil,                                         // 84: StartEmulatorRequest.HardwarePropertiesEntry
+	(*empty.Empty)(nil),                         // 85: google.protobuf.Empty
 }
 var file_agent_proto_depIdxs = []int32{
 	0,  // 0: AgentState.emulator_state:type_name -> AgentState.EmulatorState
-	1,  // 1: StreamDisplayRequest.format:type_name -> StreamDisplayRequest.FrameFormat
-	11, // 2: InputRequest.touch:type_name -> TouchEvent
-	13, // 3: ShellRequest.start:type_name -> ShellStartRequest
-	14, // 4: ShellRequest.stdin:type_name -> ShellStdInRequest
-	15, // 5: ShellRequest.resize:type_name -> ShellResizeRequest
-	2,  // 6: ShellStartRequest.shell_type:type_name -> ShellStartRequest.ShellType
-	17, // 7: ShellResponse.output:type_name -> ShellOutputResponse
-	18, // 8: ShellResponse.exit:type_name -> ShellExitResponse
-	3,  // 9: ShellOutputResponse.channel:type_name -> ShellOutputResponse.ShellOutputChannel
-	21, // 10: ListDirectoryResponse.entries:type_name -> ListDirectoryEntry
-	22, // 11: ListDirectoryEntry.stat_value:type_name -> FileStat
-	22, // 12: StatFileResponse.stat_value:type_name -> FileStat
-	28, // 13: PushFileRequest.start:type_name -> PushFileStartRequest
-	29, // 14: PushFileRequest.data:type_name -> PushFileDataRequest
-	30, // 15: PushFileRequest.end:type_name -> PushFileEndRequest
-	31, // 16: AgentController.streamState:input_type -> google.protobuf.Empty
-	5,  // 17: AgentController.startEmulator:input_type -> StartEmulatorRequest
-	6,  // 18: AgentController.stopEmulator:input_type -> StopEmulatorRequest
-	7,  // 19: AgentController.streamDisplay:input_type -> StreamDisplayRequest
-	31, // 20: AgentController.streamSysLog:input_type -> google.protobuf.Empty
-	10, // 21: AgentController.sendInput:input_type -> InputRequest
-	12, // 22: AgentController.openShell:input_type -> ShellRequest
-	19, // 23: AgentController.listDirectory:input_type -> ListDirectoryRequest
-	23, // 24: AgentController.statFile:input_type -> StatFileRequest
-	25, // 25: AgentController.pullFile:input_type -> PullFileRequest
-	27, // 26: AgentController.pushFile:input_type -> PushFileRequest
-	4,  // 27: AgentController.streamState:output_type -> AgentState
-	31, // 28: AgentController.startEmulator:output_type -> google.protobuf.Empty
-	31, // 29: AgentController.stopEmulator:output_type -> google.protobuf.Empty
-	8,  // 30: AgentController.streamDisplay:output_type -> DisplayFrame
-	9,  // 31: AgentController.streamSysLog:output_type -> SysLogEntry
-	31, // 32: AgentController.sendInput:output_type -> google.protobuf.Empty
-	16, // 33: AgentController.openShell:output_type -> ShellResponse
-	20, // 34: AgentController.listDirectory:output_type -> ListDirectoryResponse
-	24, // 35: AgentController.statFile:output_type -> StatFileResponse
-	26, // 36: AgentController.pullFile:output_type -> PullFileResponse
-	31, // 37: AgentController.pushFile:output_type -> google.protobuf.Empty
-	27, // [27:38] is the sub-list for method output_type
-	16, // [16:27] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	1,  // 1: AgentState.boot_stage:type_name -> AgentState.BootStage
+	22, // 2: AgentState.boot_progress:type_name -> BootProgress
+	23, // 3: AgentState.config:type_name -> StartEmulatorRequest
+	28, // 4: AgentState.system_image:type_name -> SystemImage
+	0,  // 5: AgentState.previous_state:type_name -> AgentState.EmulatorState
+	20, // 6: AgentState.fatal_events:type_name -> FatalEvent
+	2,  // 7: FatalEvent.type:type_name -> FatalEvent.Type
+	1,  // 8: BootProgress.stage:type_name -> AgentState.BootStage
+	37, // 9: StartEmulatorRequest.secondary_displays:type_name -> SecondaryDisplay
+	3,  // 10: StartEmulatorRequest.gpu_mode:type_name -> StartEmulatorRequest.GpuMode
+	4,  // 11: StartEmulatorRequest.camera_back:type_name -> StartEmulatorRequest.Camera
+	4,  // 12: StartEmulatorRequest.camera_front:type_name -> StartEmulatorRequest.Camera
+	5,  // 13: StartEmulatorRequest.network_profile:type_name -> StartEmulatorRequest.NetworkProfile
+	6,  // 14: StartEmulatorRequest.orientation:type_name -> StartEmulatorRequest.Orientation
+	84, // 15: StartEmulatorRequest.hardware_properties:type_name -> StartEmulatorRequest.HardwarePropertiesEntry
+	25, // 16: StartEmulatorRequest.image_overrides:type_name -> ImageOverrides
+	24, // 17: StartEmulatorRequest.restart_policy:type_name -> RestartPolicy
+	7,  // 18: RestartPolicy.mode:type_name -> RestartPolicy.Mode
+	8,  // 19: VmState.state:type_name -> VmState.RunState
+	28, // 20: ListSystemImagesResponse.images:type_name -> SystemImage
+	36, // 21: ListSnapshotsResponse.snapshots:type_name -> Snapshot
+	39, // 22: ListDisplaysResponse.displays:type_name -> Display
+	9,  // 23: ScreenshotRequest.format:type_name -> ScreenshotRequest.ImageFormat
+	10, // 24: StreamDisplayRequest.format:type_name -> StreamDisplayRequest.FrameFormat
+	11, // 25: StartRecordingRequest.format:type_name -> StartRecordingRequest.ContainerFormat
+	11, // 26: Recording.format:type_name -> StartRecordingRequest.ContainerFormat
+	12, // 27: Recording.state:type_name -> Recording.RecordingState
+	47, // 28: ListRecordingsResponse.recordings:type_name -> Recording
+	13, // 29: SysLogEntry.level:type_name -> SysLogEntry.Level
+	14, // 30: StreamEmulatorLogRequest.min_severity:type_name -> EmulatorLogEntry.Severity
+	14, // 31: EmulatorLogEntry.severity:type_name -> EmulatorLogEntry.Severity
+	15, // 32: EmulatorLogEntry.stream:type_name -> EmulatorLogEntry.Stream
+	58, // 33: InputRequest.touch:type_name -> TouchEvent
+	59, // 34: InputRequest.key:type_name -> KeyEvent
+	16, // 35: KeyEvent.type:type_name -> KeyEvent.KeyEventType
+	61, // 36: ShellRequest.start:type_name -> ShellStartRequest
+	62, // 37: ShellRequest.stdin:type_name -> ShellStdInRequest
+	63, // 38: ShellRequest.resize:type_name -> ShellResizeRequest
+	17, // 39: ShellStartRequest.shell_type:type_name -> ShellStartRequest.ShellType
+	65, // 40: ShellResponse.output:type_name -> ShellOutputResponse
+	66, // 41: ShellResponse.exit:type_name -> ShellExitResponse
+	18, // 42: ShellOutputResponse.channel:type_name -> ShellOutputResponse.ShellOutputChannel
+	69, // 43: ListDirectoryResponse.entries:type_name -> ListDirectoryEntry
+	70, // 44: ListDirectoryEntry.stat_value:type_name -> FileStat
+	70, // 45: StatFileResponse.stat_value:type_name -> FileStat
+	76, // 46: PushFileRequest.start:type_name -> PushFileStartRequest
+	77, // 47: PushFileRequest.data:type_name -> PushFileDataRequest
+	78, // 48: PushFileRequest.end:type_name -> PushFileEndRequest
+	80, // 49: UploadImageRequest.start:type_name -> UploadImageStartRequest
+	81, // 50: UploadImageRequest.data:type_name -> UploadImageDataRequest
+	21, // 51: AgentController.streamState:input_type -> StreamStateRequest
+	23, // 52: AgentController.startEmulator:input_type -> StartEmulatorRequest
+	42, // 53: AgentController.stopEmulator:input_type -> StopEmulatorRequest
+	85, // 54: AgentController.restartEmulator:input_type -> google.protobuf.Empty
+	85, // 55: AgentController.pauseEmulator:input_type -> google.protobuf.Empty
+	85, // 56: AgentController.resumeEmulator:input_type -> google.protobuf.Empty
+	85, // 57: AgentController.getVmState:input_type -> google.protobuf.Empty
+	85, // 58: AgentController.listSystemImages:input_type -> google.protobuf.Empty
+	29, // 59: AgentController.saveSnapshot:input_type -> SaveSnapshotRequest
+	30, // 60: AgentController.loadSnapshot:input_type -> LoadSnapshotRequest
+	85, // 61: AgentController.listSnapshots:input_type -> google.protobuf.Empty
+	31, // 62: AgentController.deleteSnapshot:input_type -> DeleteSnapshotRequest
+	32, // 63: AgentController.exportSnapshot:input_type -> ExportSnapshotRequest
+	34, // 64: AgentController.importSnapshot:input_type -> ImportSnapshotRequest
+	85, // 65: AgentController.listDisplays:input_type -> google.protobuf.Empty
+	40, // 66: AgentController.getScreenshot:input_type -> ScreenshotRequest
+	43, // 67: AgentController.streamDisplay:input_type -> StreamDisplayRequest
+	45, // 68: AgentController.requestKeyframe:input_type -> RequestKeyframeRequest
+	46, // 69: AgentController.startRecording:input_type -> StartRecordingRequest
+	48, // 70: AgentController.stopRecording:input_type -> StopRecordingRequest
+	85, // 71: AgentController.listRecordings:input_type -> google.protobuf.Empty
+	50, // 72: AgentController.downloadRecording:input_type -> DownloadRecordingRequest
+	52, // 73: AgentController.deleteRecording:input_type -> DeleteRecordingRequest
+	53, // 74: AgentController.streamSysLog:input_type -> StreamSysLogRequest
+	55, // 75: AgentController.streamEmulatorLog:input_type -> StreamEmulatorLogRequest
+	57, // 76: AgentController.sendInput:input_type -> InputRequest
+	60, // 77: AgentController.openShell:input_type -> ShellRequest
+	67, // 78: AgentController.listDirectory:input_type -> ListDirectoryRequest
+	71, // 79: AgentController.statFile:input_type -> StatFileRequest
+	73, // 80: AgentController.pullFile:input_type -> PullFileRequest
+	75, // 81: AgentController.pushFile:input_type -> PushFileRequest
+	79, // 82: AgentController.uploadImage:input_type -> UploadImageRequest
+	83, // 83: AgentController.deleteImage:input_type -> DeleteImageRequest
+	19, // 84: AgentController.streamState:output_type -> AgentState
+	85, // 85: AgentController.startEmulator:output_type -> google.protobuf.Empty
+	85, // 86: AgentController.stopEmulator:output_type -> google.protobuf.Empty
+	85, // 87: AgentController.restartEmulator:output_type -> google.protobuf.Empty
+	85, // 88: AgentController.pauseEmulator:output_type -> google.protobuf.Empty
+	85, // 89: AgentController.resumeEmulator:output_type -> google.protobuf.Empty
+	26, // 90: AgentController.getVmState:output_type -> VmState
+	27, // 91: AgentController.listSystemImages:output_type -> ListSystemImagesResponse
+	36, // 92: AgentController.saveSnapshot:output_type -> Snapshot
+	85, // 93: AgentController.loadSnapshot:output_type -> google.protobuf.Empty
+	35, // 94: AgentController.listSnapshots:output_type -> ListSnapshotsResponse
+	85, // 95: AgentController.deleteSnapshot:output_type -> google.protobuf.Empty
+	33, // 96: AgentController.exportSnapshot:output_type -> ExportSnapshotResponse
+	36, // 97: AgentController.importSnapshot:output_type -> Snapshot
+	38, // 98: AgentController.listDisplays:output_type -> ListDisplaysResponse
+	41, // 99: AgentController.getScreenshot:output_type -> Screenshot
+	44, // 100: AgentController.streamDisplay:output_type -> DisplayFrame
+	85, // 101: AgentController.requestKeyframe:output_type -> google.protobuf.Empty
+	47, // 102: AgentController.startRecording:output_type -> Recording
+	47, // 103: AgentController.stopRecording:output_type -> Recording
+	49, // 104: AgentController.listRecordings:output_type -> ListRecordingsResponse
+	51, // 105: AgentController.downloadRecording:output_type -> DownloadRecordingResponse
+	85, // 106: AgentController.deleteRecording:output_type -> google.protobuf.Empty
+	54, // 107: AgentController.streamSysLog:output_type -> SysLogEntry
+	56, // 108: AgentController.streamEmulatorLog:output_type -> EmulatorLogEntry
+	85, // 109: AgentController.sendInput:output_type -> google.protobuf.Empty
+	64, // 110: AgentController.openShell:output_type -> ShellResponse
+	68, // 111: AgentController.listDirectory:output_type -> ListDirectoryResponse
+	72, // 112: AgentController.statFile:output_type -> StatFileResponse
+	74, // 113: AgentController.pullFile:output_type -> PullFileResponse
+	85, // 114: AgentController.pushFile:output_type -> google.protobuf.Empty
+	82, // 115: AgentController.uploadImage:output_type -> UploadedImage
+	85, // 116: AgentController.deleteImage:output_type -> google.protobuf.Empty
+	84, // [84:117] is the sub-list for method output_type
+	51, // [51:84] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_agent_proto_init() }
@@ -2421,8 +6583,212 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartEmulatorRequest); i {
+		file_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FatalEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartEmulatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageOverrides); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VmState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSystemImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecondaryDisplay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2433,7 +6799,55 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDisplaysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Display); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Screenshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopEmulatorRequest); i {
 			case 0:
 				return &v.state
@@ -2445,7 +6859,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamDisplayRequest); i {
 			case 0:
 				return &v.state
@@ -2457,7 +6871,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisplayFrame); i {
 			case 0:
 				return &v.state
@@ -2469,7 +6883,115 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestKeyframeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRecordingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recording); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRecordingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecordingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadRecordingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadRecordingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRecordingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSysLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SysLogEntry); i {
 			case 0:
 				return &v.state
@@ -2481,7 +7003,31 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEmulatorLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmulatorLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InputRequest); i {
 			case 0:
 				return &v.state
@@ -2493,7 +7039,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TouchEvent); i {
 			case 0:
 				return &v.state
@@ -2505,7 +7051,19 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShellRequest); i {
 			case 0:
 				return &v.state
@@ -2517,7 +7075,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShellStartRequest); i {
 			case 0:
 				return &v.state
@@ -2529,7 +7087,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShellStdInRequest); i {
 			case 0:
 				return &v.state
@@ -2541,7 +7099,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShellResizeRequest); i {
 			case 0:
 				return &v.state
@@ -2553,7 +7111,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShellResponse); i {
 			case 0:
 				return &v.state
@@ -2565,7 +7123,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShellOutputResponse); i {
 			case 0:
 				return &v.state
@@ -2577,7 +7135,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShellExitResponse); i {
 			case 0:
 				return &v.state
@@ -2589,7 +7147,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDirectoryRequest); i {
 			case 0:
 				return &v.state
@@ -2601,7 +7159,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDirectoryResponse); i {
 			case 0:
 				return &v.state
@@ -2613,7 +7171,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDirectoryEntry); i {
 			case 0:
 				return &v.state
@@ -2625,7 +7183,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileStat); i {
 			case 0:
 				return &v.state
@@ -2637,7 +7195,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatFileRequest); i {
 			case 0:
 				return &v.state
@@ -2649,7 +7207,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatFileResponse); i {
 			case 0:
 				return &v.state
@@ -2661,7 +7219,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PullFileRequest); i {
 			case 0:
 				return &v.state
@@ -2673,7 +7231,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PullFileResponse); i {
 			case 0:
 				return &v.state
@@ -2685,7 +7243,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushFileRequest); i {
 			case 0:
 				return &v.state
@@ -2697,7 +7255,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushFileStartRequest); i {
 			case 0:
 				return &v.state
@@ -2709,7 +7267,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushFileDataRequest); i {
 			case 0:
 				return &v.state
@@ -2721,7 +7279,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushFileEndRequest); i {
 			case 0:
 				return &v.state
@@ -2733,41 +7291,110 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
+		file_agent_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageStartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadedImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_agent_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_agent_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_agent_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_agent_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_agent_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_agent_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_agent_proto_msgTypes[38].OneofWrappers = []interface{}{
 		(*InputRequest_Touch)(nil),
+		(*InputRequest_Key)(nil),
 	}
-	file_agent_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_agent_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*ShellRequest_Start)(nil),
 		(*ShellRequest_Stdin)(nil),
 		(*ShellRequest_Resize)(nil),
 	}
-	file_agent_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_agent_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_agent_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_agent_proto_msgTypes[45].OneofWrappers = []interface{}{
 		(*ShellResponse_Output)(nil),
 		(*ShellResponse_Exit)(nil),
 	}
-	file_agent_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_agent_proto_msgTypes[50].OneofWrappers = []interface{}{
 		(*ListDirectoryEntry_StatError)(nil),
 		(*ListDirectoryEntry_StatValue)(nil),
 	}
-	file_agent_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_agent_proto_msgTypes[53].OneofWrappers = []interface{}{
 		(*StatFileResponse_StatError)(nil),
 		(*StatFileResponse_StatValue)(nil),
 	}
-	file_agent_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_agent_proto_msgTypes[56].OneofWrappers = []interface{}{
 		(*PushFileRequest_Start)(nil),
 		(*PushFileRequest_Data)(nil),
 		(*PushFileRequest_End)(nil),
 	}
+	file_agent_proto_msgTypes[60].OneofWrappers = []interface{}{
+		(*UploadImageRequest_Start)(nil),
+		(*UploadImageRequest_Data)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_agent_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   27,
+			NumEnums:      19,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,21 +25,80 @@ const _ = grpc.SupportPackageIsVersion7
 type AgentControllerClient interface {
 	// Streams the state of the agent process.
 	// An initial value will be immediately produced with the current agent state. Subsequent values may indicate a change
-	// in the agent state, however this is not guaranteed and the same state can be delivered multiple times.
-	StreamState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (AgentController_StreamStateClient, error)
+	// in the agent state, however this is not guaranteed and the same state can be delivered multiple times, unless
+	// transitions_only is set.
+	StreamState(ctx context.Context, in *StreamStateRequest, opts ...grpc.CallOption) (AgentController_StreamStateClient, error)
 	// Requests the emulator starts. An error will be returned if the emulator is already running.
 	StartEmulator(ctx context.Context, in *StartEmulatorRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Requests the emulator exits. An error will be returned if the emulator is not running.
 	StopEmulator(ctx context.Context, in *StopEmulatorRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Starts the emulator again with the last startEmulator request. The emulator must not be running.
+	RestartEmulator(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Pauses the emulator, freezing the guest until resumed.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	PauseEmulator(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Resumes a paused emulator.
+	// Requires that the emulator is in the "paused" state, otherwise an error will be returned.
+	ResumeEmulator(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Gets the run state of the virtual machine, as reported by the emulator.
+	// Requires that the emulator is in the "running" or "paused" state, otherwise an error will be returned.
+	GetVmState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VmState, error)
+	// Lists the system images installed in the agent container.
+	ListSystemImages(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListSystemImagesResponse, error)
+	// Saves the state of the running emulator as a named snapshot, replacing any existing snapshot of the same name.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	SaveSnapshot(ctx context.Context, in *SaveSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error)
+	// Restores the running emulator to a snapshot.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	LoadSnapshot(ctx context.Context, in *LoadSnapshotRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Lists the stored snapshots.
+	ListSnapshots(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// Deletes a stored snapshot. An error will be returned if the emulator is starting.
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Exports a snapshot, along with the AVD data it depends on, as a gzip compressed tar archive.
+	// Requires that the emulator is stopped, otherwise an error will be returned.
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (AgentController_ExportSnapshotClient, error)
+	// Imports a snapshot archive produced by exportSnapshot, replacing the AVD data and all existing snapshots. The
+	// archive must have been exported by an agent with the same emulator version and system image build, and is verified
+	// before any existing data is replaced. The emulator can then be started from the imported snapshot.
+	// Requires that the emulator is stopped, otherwise an error will be returned.
+	ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (AgentController_ImportSnapshotClient, error)
+	// Lists the displays attached to the emulator, including the main display.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	ListDisplays(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListDisplaysResponse, error)
+	// Captures a single screenshot of the requested display.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	GetScreenshot(ctx context.Context, in *ScreenshotRequest, opts ...grpc.CallOption) (*Screenshot, error)
 	// Streams the display in the requested format.
 	// An initial value will be immediately produced with the current display content. This stream can and should be
 	// started before the emulator is started to ensure no frames are missed. The stream will is persistent between
 	// emulator restarts.
 	StreamDisplay(ctx context.Context, in *StreamDisplayRequest, opts ...grpc.CallOption) (AgentController_StreamDisplayClient, error)
+	// Requests the next frame of an open display stream is encoded as a keyframe.
+	// This behaves similarly to an RTP picture loss indication (PLI), allowing viewers that have lost data or joined a
+	// shared stream to resynchronise without waiting for the next keyframe interval. The stream is identified by the
+	// stream id reported in each DisplayFrame. An error will be returned if the stream is not open.
+	RequestKeyframe(ctx context.Context, in *RequestKeyframeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Starts recording a display to a file stored on the agent.
+	// The recording is persistent between emulator restarts, with periods where the display is off skipped.
+	StartRecording(ctx context.Context, in *StartRecordingRequest, opts ...grpc.CallOption) (*Recording, error)
+	// Stops a recording and finalises the file. An error will be returned if the recording is not found.
+	StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*Recording, error)
+	// Lists the recordings stored on the agent.
+	ListRecordings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListRecordingsResponse, error)
+	// Downloads a finished recording. An error will be returned if the recording is still in progress.
+	DownloadRecording(ctx context.Context, in *DownloadRecordingRequest, opts ...grpc.CallOption) (AgentController_DownloadRecordingClient, error)
+	// Deletes a recording, stopping it first if required.
+	DeleteRecording(ctx context.Context, in *DeleteRecordingRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Streams the system log (kernel messages).
-	// Previous messages are not returned. This stream can and should be started before the emulator is started to ensure
-	// no messages are missed. The stream will is persistent between emulator restarts.
-	StreamSysLog(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (AgentController_StreamSysLogClient, error)
+	// Recent messages are retained, and are returned first when replay is set. Otherwise previous messages are not
+	// returned, and the stream should be started before the emulator is started to ensure no messages are missed. The
+	// stream is persistent between emulator restarts.
+	StreamSysLog(ctx context.Context, in *StreamSysLogRequest, opts ...grpc.CallOption) (AgentController_StreamSysLogClient, error)
+	// Streams the output of the emulator process.
+	// Recent output is retained, so the output of a boot can be requested after the emulator has started. The stream is
+	// persistent between emulator restarts.
+	StreamEmulatorLog(ctx context.Context, in *StreamEmulatorLogRequest, opts ...grpc.CallOption) (AgentController_StreamEmulatorLogClient, error)
 	// Forward an input event to the emulator.
 	SendInput(ctx context.Context, in *InputRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Opens an ADB shell to the emulator.
@@ -59,6 +118,11 @@ type AgentControllerClient interface {
 	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
 	// The request stream must start with a single PushFileStartRequest message.
 	PushFile(ctx context.Context, opts ...grpc.CallOption) (AgentController_PushFileClient, error)
+	// Uploads an image to the agent, for use as an image override when starting the emulator. The stream must begin with
+	// a start request. The image is stored once the client closes the stream, replacing any image of the same name.
+	UploadImage(ctx context.Context, opts ...grpc.CallOption) (AgentController_UploadImageClient, error)
+	// Deletes an uploaded image.
+	DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type agentControllerClient struct {
@@ -69,7 +133,7 @@ func NewAgentControllerClient(cc grpc.ClientConnInterface) AgentControllerClient
 	return &agentControllerClient{cc}
 }
 
-func (c *agentControllerClient) StreamState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (AgentController_StreamStateClient, error) {
+func (c *agentControllerClient) StreamState(ctx context.Context, in *StreamStateRequest, opts ...grpc.CallOption) (AgentController_StreamStateClient, error) {
 	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[0], "/AgentController/streamState", opts...)
 	if err != nil {
 		return nil, err
@@ -119,8 +183,173 @@ func (c *agentControllerClient) StopEmulator(ctx context.Context, in *StopEmulat
 	return out, nil
 }
 
+func (c *agentControllerClient) RestartEmulator(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/AgentController/restartEmulator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) PauseEmulator(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/AgentController/pauseEmulator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) ResumeEmulator(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/AgentController/resumeEmulator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) GetVmState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VmState, error) {
+	out := new(VmState)
+	err := c.cc.Invoke(ctx, "/AgentController/getVmState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) ListSystemImages(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListSystemImagesResponse, error) {
+	out := new(ListSystemImagesResponse)
+	err := c.cc.Invoke(ctx, "/AgentController/listSystemImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) SaveSnapshot(ctx context.Context, in *SaveSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error) {
+	out := new(Snapshot)
+	err := c.cc.Invoke(ctx, "/AgentController/saveSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) LoadSnapshot(ctx context.Context, in *LoadSnapshotRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/AgentController/loadSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) ListSnapshots(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/AgentController/listSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/AgentController/deleteSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (AgentController_ExportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[1], "/AgentController/exportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentControllerExportSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentController_ExportSnapshotClient interface {
+	Recv() (*ExportSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type agentControllerExportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentControllerExportSnapshotClient) Recv() (*ExportSnapshotResponse, error) {
+	m := new(ExportSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentControllerClient) ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (AgentController_ImportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[2], "/AgentController/importSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentControllerImportSnapshotClient{stream}
+	return x, nil
+}
+
+type AgentController_ImportSnapshotClient interface {
+	Send(*ImportSnapshotRequest) error
+	CloseAndRecv() (*Snapshot, error)
+	grpc.ClientStream
+}
+
+type agentControllerImportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentControllerImportSnapshotClient) Send(m *ImportSnapshotRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentControllerImportSnapshotClient) CloseAndRecv() (*Snapshot, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Snapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentControllerClient) ListDisplays(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListDisplaysResponse, error) {
+	out := new(ListDisplaysResponse)
+	err := c.cc.Invoke(ctx, "/AgentController/listDisplays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) GetScreenshot(ctx context.Context, in *ScreenshotRequest, opts ...grpc.CallOption) (*Screenshot, error) {
+	out := new(Screenshot)
+	err := c.cc.Invoke(ctx, "/AgentController/getScreenshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentControllerClient) StreamDisplay(ctx context.Context, in *StreamDisplayRequest, opts ...grpc.CallOption) (AgentController_StreamDisplayClient, error) {
-	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[1], "/AgentController/streamDisplay", opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[3], "/AgentController/streamDisplay", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -151,8 +380,85 @@ func (x *agentControllerStreamDisplayClient) Recv() (*DisplayFrame, error) {
 	return m, nil
 }
 
-func (c *agentControllerClient) StreamSysLog(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (AgentController_StreamSysLogClient, error) {
-	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[2], "/AgentController/streamSysLog", opts...)
+func (c *agentControllerClient) RequestKeyframe(ctx context.Context, in *RequestKeyframeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/AgentController/requestKeyframe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) StartRecording(ctx context.Context, in *StartRecordingRequest, opts ...grpc.CallOption) (*Recording, error) {
+	out := new(Recording)
+	err := c.cc.Invoke(ctx, "/AgentController/startRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*Recording, error) {
+	out := new(Recording)
+	err := c.cc.Invoke(ctx, "/AgentController/stopRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) ListRecordings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListRecordingsResponse, error) {
+	out := new(ListRecordingsResponse)
+	err := c.cc.Invoke(ctx, "/AgentController/listRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) DownloadRecording(ctx context.Context, in *DownloadRecordingRequest, opts ...grpc.CallOption) (AgentController_DownloadRecordingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[4], "/AgentController/downloadRecording", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentControllerDownloadRecordingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentController_DownloadRecordingClient interface {
+	Recv() (*DownloadRecordingResponse, error)
+	grpc.ClientStream
+}
+
+type agentControllerDownloadRecordingClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentControllerDownloadRecordingClient) Recv() (*DownloadRecordingResponse, error) {
+	m := new(DownloadRecordingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentControllerClient) DeleteRecording(ctx context.Context, in *DeleteRecordingRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/AgentController/deleteRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControllerClient) StreamSysLog(ctx context.Context, in *StreamSysLogRequest, opts ...grpc.CallOption) (AgentController_StreamSysLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[5], "/AgentController/streamSysLog", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +489,38 @@ func (x *agentControllerStreamSysLogClient) Recv() (*SysLogEntry, error) {
 	return m, nil
 }
 
+func (c *agentControllerClient) StreamEmulatorLog(ctx context.Context, in *StreamEmulatorLogRequest, opts ...grpc.CallOption) (AgentController_StreamEmulatorLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[6], "/AgentController/streamEmulatorLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentControllerStreamEmulatorLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentController_StreamEmulatorLogClient interface {
+	Recv() (*EmulatorLogEntry, error)
+	grpc.ClientStream
+}
+
+type agentControllerStreamEmulatorLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentControllerStreamEmulatorLogClient) Recv() (*EmulatorLogEntry, error) {
+	m := new(EmulatorLogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *agentControllerClient) SendInput(ctx context.Context, in *InputRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/AgentController/sendInput", in, out, opts...)
@@ -193,7 +531,7 @@ func (c *agentControllerClient) SendInput(ctx context.Context, in *InputRequest,
 }
 
 func (c *agentControllerClient) OpenShell(ctx context.Context, opts ...grpc.CallOption) (AgentController_OpenShellClient, error) {
-	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[3], "/AgentController/openShell", opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[7], "/AgentController/openShell", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -242,7 +580,7 @@ func (c *agentControllerClient) StatFile(ctx context.Context, in *StatFileReques
 }
 
 func (c *agentControllerClient) PullFile(ctx context.Context, in *PullFileRequest, opts ...grpc.CallOption) (AgentController_PullFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[4], "/AgentController/pullFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[8], "/AgentController/pullFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -274,7 +612,7 @@ func (x *agentControllerPullFileClient) Recv() (*PullFileResponse, error) {
 }
 
 func (c *agentControllerClient) PushFile(ctx context.Context, opts ...grpc.CallOption) (AgentController_PushFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[5], "/AgentController/pushFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[9], "/AgentController/pushFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -307,27 +645,129 @@ func (x *agentControllerPushFileClient) CloseAndRecv() (*empty.Empty, error) {
 	return m, nil
 }
 
+func (c *agentControllerClient) UploadImage(ctx context.Context, opts ...grpc.CallOption) (AgentController_UploadImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentController_ServiceDesc.Streams[10], "/AgentController/uploadImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentControllerUploadImageClient{stream}
+	return x, nil
+}
+
+type AgentController_UploadImageClient interface {
+	Send(*UploadImageRequest) error
+	CloseAndRecv() (*UploadedImage, error)
+	grpc.ClientStream
+}
+
+type agentControllerUploadImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentControllerUploadImageClient) Send(m *UploadImageRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentControllerUploadImageClient) CloseAndRecv() (*UploadedImage, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadedImage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentControllerClient) DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/AgentController/deleteImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentControllerServer is the server API for AgentController service.
 // All implementations must embed UnimplementedAgentControllerServer
 // for forward compatibility
 type AgentControllerServer interface {
 	// Streams the state of the agent process.
 	// An initial value will be immediately produced with the current agent state. Subsequent values may indicate a change
-	// in the agent state, however this is not guaranteed and the same state can be delivered multiple times.
-	StreamState(*empty.Empty, AgentController_StreamStateServer) error
+	// in the agent state, however this is not guaranteed and the same state can be delivered multiple times, unless
+	// transitions_only is set.
+	StreamState(*StreamStateRequest, AgentController_StreamStateServer) error
 	// Requests the emulator starts. An error will be returned if the emulator is already running.
 	StartEmulator(context.Context, *StartEmulatorRequest) (*empty.Empty, error)
 	// Requests the emulator exits. An error will be returned if the emulator is not running.
 	StopEmulator(context.Context, *StopEmulatorRequest) (*empty.Empty, error)
+	// Starts the emulator again with the last startEmulator request. The emulator must not be running.
+	RestartEmulator(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Pauses the emulator, freezing the guest until resumed.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	PauseEmulator(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Resumes a paused emulator.
+	// Requires that the emulator is in the "paused" state, otherwise an error will be returned.
+	ResumeEmulator(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Gets the run state of the virtual machine, as reported by the emulator.
+	// Requires that the emulator is in the "running" or "paused" state, otherwise an error will be returned.
+	GetVmState(context.Context, *empty.Empty) (*VmState, error)
+	// Lists the system images installed in the agent container.
+	ListSystemImages(context.Context, *empty.Empty) (*ListSystemImagesResponse, error)
+	// Saves the state of the running emulator as a named snapshot, replacing any existing snapshot of the same name.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	SaveSnapshot(context.Context, *SaveSnapshotRequest) (*Snapshot, error)
+	// Restores the running emulator to a snapshot.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	LoadSnapshot(context.Context, *LoadSnapshotRequest) (*empty.Empty, error)
+	// Lists the stored snapshots.
+	ListSnapshots(context.Context, *empty.Empty) (*ListSnapshotsResponse, error)
+	// Deletes a stored snapshot. An error will be returned if the emulator is starting.
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*empty.Empty, error)
+	// Exports a snapshot, along with the AVD data it depends on, as a gzip compressed tar archive.
+	// Requires that the emulator is stopped, otherwise an error will be returned.
+	ExportSnapshot(*ExportSnapshotRequest, AgentController_ExportSnapshotServer) error
+	// Imports a snapshot archive produced by exportSnapshot, replacing the AVD data and all existing snapshots. The
+	// archive must have been exported by an agent with the same emulator version and system image build, and is verified
+	// before any existing data is replaced. The emulator can then be started from the imported snapshot.
+	// Requires that the emulator is stopped, otherwise an error will be returned.
+	ImportSnapshot(AgentController_ImportSnapshotServer) error
+	// Lists the displays attached to the emulator, including the main display.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	ListDisplays(context.Context, *empty.Empty) (*ListDisplaysResponse, error)
+	// Captures a single screenshot of the requested display.
+	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
+	GetScreenshot(context.Context, *ScreenshotRequest) (*Screenshot, error)
 	// Streams the display in the requested format.
 	// An initial value will be immediately produced with the current display content. This stream can and should be
 	// started before the emulator is started to ensure no frames are missed. The stream will is persistent between
 	// emulator restarts.
 	StreamDisplay(*StreamDisplayRequest, AgentController_StreamDisplayServer) error
+	// Requests the next frame of an open display stream is encoded as a keyframe.
+	// This behaves similarly to an RTP picture loss indication (PLI), allowing viewers that have lost data or joined a
+	// shared stream to resynchronise without waiting for the next keyframe interval. The stream is identified by the
+	// stream id reported in each DisplayFrame. An error will be returned if the stream is not open.
+	RequestKeyframe(context.Context, *RequestKeyframeRequest) (*empty.Empty, error)
+	// Starts recording a display to a file stored on the agent.
+	// The recording is persistent between emulator restarts, with periods where the display is off skipped.
+	StartRecording(context.Context, *StartRecordingRequest) (*Recording, error)
+	// Stops a recording and finalises the file. An error will be returned if the recording is not found.
+	StopRecording(context.Context, *StopRecordingRequest) (*Recording, error)
+	// Lists the recordings stored on the agent.
+	ListRecordings(context.Context, *empty.Empty) (*ListRecordingsResponse, error)
+	// Downloads a finished recording. An error will be returned if the recording is still in progress.
+	DownloadRecording(*DownloadRecordingRequest, AgentController_DownloadRecordingServer) error
+	// Deletes a recording, stopping it first if required.
+	DeleteRecording(context.Context, *DeleteRecordingRequest) (*empty.Empty, error)
 	// Streams the system log (kernel messages).
-	// Previous messages are not returned. This stream can and should be started before the emulator is started to ensure
-	// no messages are missed. The stream will is persistent between emulator restarts.
-	StreamSysLog(*empty.Empty, AgentController_StreamSysLogServer) error
+	// Recent messages are retained, and are returned first when replay is set. Otherwise previous messages are not
+	// returned, and the stream should be started before the emulator is started to ensure no messages are missed. The
+	// stream is persistent between emulator restarts.
+	StreamSysLog(*StreamSysLogRequest, AgentController_StreamSysLogServer) error
+	// Streams the output of the emulator process.
+	// Recent output is retained, so the output of a boot can be requested after the emulator has started. The stream is
+	// persistent between emulator restarts.
+	StreamEmulatorLog(*StreamEmulatorLogRequest, AgentController_StreamEmulatorLogServer) error
 	// Forward an input event to the emulator.
 	SendInput(context.Context, *InputRequest) (*empty.Empty, error)
 	// Opens an ADB shell to the emulator.
@@ -347,6 +787,11 @@ type AgentControllerServer interface {
 	// Requires that the emulator has reached the "running" state, otherwise an error will be returned.
 	// The request stream must start with a single PushFileStartRequest message.
 	PushFile(AgentController_PushFileServer) error
+	// Uploads an image to the agent, for use as an image override when starting the emulator. The stream must begin with
+	// a start request. The image is stored once the client closes the stream, replacing any image of the same name.
+	UploadImage(AgentController_UploadImageServer) error
+	// Deletes an uploaded image.
+	DeleteImage(context.Context, *DeleteImageRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedAgentControllerServer()
 }
 
@@ -354,7 +799,7 @@ type AgentControllerServer interface {
 type UnimplementedAgentControllerServer struct {
 }
 
-func (UnimplementedAgentControllerServer) StreamState(*empty.Empty, AgentController_StreamStateServer) error {
+func (UnimplementedAgentControllerServer) StreamState(*StreamStateRequest, AgentController_StreamStateServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamState not implemented")
 }
 func (UnimplementedAgentControllerServer) StartEmulator(context.Context, *StartEmulatorRequest) (*empty.Empty, error) {
@@ -363,31 +808,97 @@ func (UnimplementedAgentControllerServer) StartEmulator(context.Context, *StartE
 func (UnimplementedAgentControllerServer) StopEmulator(context.Context, *StopEmulatorRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEmulator not implemented")
 }
-func (UnimplementedAgentControllerServer) StreamDisplay(*StreamDisplayRequest, AgentController_StreamDisplayServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamDisplay not implemented")
+func (UnimplementedAgentControllerServer) RestartEmulator(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartEmulator not implemented")
 }
-func (UnimplementedAgentControllerServer) StreamSysLog(*empty.Empty, AgentController_StreamSysLogServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamSysLog not implemented")
+func (UnimplementedAgentControllerServer) PauseEmulator(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseEmulator not implemented")
 }
-func (UnimplementedAgentControllerServer) SendInput(context.Context, *InputRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendInput not implemented")
+func (UnimplementedAgentControllerServer) ResumeEmulator(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeEmulator not implemented")
 }
-func (UnimplementedAgentControllerServer) OpenShell(AgentController_OpenShellServer) error {
-	return status.Errorf(codes.Unimplemented, "method OpenShell not implemented")
+func (UnimplementedAgentControllerServer) GetVmState(context.Context, *empty.Empty) (*VmState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVmState not implemented")
 }
-func (UnimplementedAgentControllerServer) ListDirectory(context.Context, *ListDirectoryRequest) (*ListDirectoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDirectory not implemented")
+func (UnimplementedAgentControllerServer) ListSystemImages(context.Context, *empty.Empty) (*ListSystemImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSystemImages not implemented")
 }
-func (UnimplementedAgentControllerServer) StatFile(context.Context, *StatFileRequest) (*StatFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatFile not implemented")
+func (UnimplementedAgentControllerServer) SaveSnapshot(context.Context, *SaveSnapshotRequest) (*Snapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSnapshot not implemented")
 }
-func (UnimplementedAgentControllerServer) PullFile(*PullFileRequest, AgentController_PullFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method PullFile not implemented")
+func (UnimplementedAgentControllerServer) LoadSnapshot(context.Context, *LoadSnapshotRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadSnapshot not implemented")
 }
-func (UnimplementedAgentControllerServer) PushFile(AgentController_PushFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method PushFile not implemented")
+func (UnimplementedAgentControllerServer) ListSnapshots(context.Context, *empty.Empty) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
 }
-func (UnimplementedAgentControllerServer) mustEmbedUnimplementedAgentControllerServer() {}
+func (UnimplementedAgentControllerServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedAgentControllerServer) ExportSnapshot(*ExportSnapshotRequest, AgentController_ExportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
+func (UnimplementedAgentControllerServer) ImportSnapshot(AgentController_ImportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSnapshot not implemented")
+}
+func (UnimplementedAgentControllerServer) ListDisplays(context.Context, *empty.Empty) (*ListDisplaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisplays not implemented")
+}
+func (UnimplementedAgentControllerServer) GetScreenshot(context.Context, *ScreenshotRequest) (*Screenshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScreenshot not implemented")
+}
+func (UnimplementedAgentControllerServer) StreamDisplay(*StreamDisplayRequest, AgentController_StreamDisplayServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDisplay not implemented")
+}
+func (UnimplementedAgentControllerServer) RequestKeyframe(context.Context, *RequestKeyframeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestKeyframe not implemented")
+}
+func (UnimplementedAgentControllerServer) StartRecording(context.Context, *StartRecordingRequest) (*Recording, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRecording not implemented")
+}
+func (UnimplementedAgentControllerServer) StopRecording(context.Context, *StopRecordingRequest) (*Recording, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecording not implemented")
+}
+func (UnimplementedAgentControllerServer) ListRecordings(context.Context, *empty.Empty) (*ListRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecordings not implemented")
+}
+func (UnimplementedAgentControllerServer) DownloadRecording(*DownloadRecordingRequest, AgentController_DownloadRecordingServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadRecording not implemented")
+}
+func (UnimplementedAgentControllerServer) DeleteRecording(context.Context, *DeleteRecordingRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecording not implemented")
+}
+func (UnimplementedAgentControllerServer) StreamSysLog(*StreamSysLogRequest, AgentController_StreamSysLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSysLog not implemented")
+}
+func (UnimplementedAgentControllerServer) StreamEmulatorLog(*StreamEmulatorLogRequest, AgentController_StreamEmulatorLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEmulatorLog not implemented")
+}
+func (UnimplementedAgentControllerServer) SendInput(context.Context, *InputRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendInput not implemented")
+}
+func (UnimplementedAgentControllerServer) OpenShell(AgentController_OpenShellServer) error {
+	return status.Errorf(codes.Unimplemented, "method OpenShell not implemented")
+}
+func (UnimplementedAgentControllerServer) ListDirectory(context.Context, *ListDirectoryRequest) (*ListDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDirectory not implemented")
+}
+func (UnimplementedAgentControllerServer) StatFile(context.Context, *StatFileRequest) (*StatFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatFile not implemented")
+}
+func (UnimplementedAgentControllerServer) PullFile(*PullFileRequest, AgentController_PullFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method PullFile not implemented")
+}
+func (UnimplementedAgentControllerServer) PushFile(AgentController_PushFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushFile not implemented")
+}
+func (UnimplementedAgentControllerServer) UploadImage(AgentController_UploadImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
+}
+func (UnimplementedAgentControllerServer) DeleteImage(context.Context, *DeleteImageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
+}
+func (UnimplementedAgentControllerServer) mustEmbedUnimplementedAgentControllerServer() {}
 
 // UnsafeAgentControllerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AgentControllerServer will
@@ -401,7 +912,7 @@ func RegisterAgentControllerServer(s grpc.ServiceRegistrar, srv AgentControllerS
 }
 
 func _AgentController_StreamState_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+	m := new(StreamStateRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -457,6 +968,251 @@ func _AgentController_StopEmulator_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentController_RestartEmulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).RestartEmulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/restartEmulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).RestartEmulator(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_PauseEmulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).PauseEmulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/pauseEmulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).PauseEmulator(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_ResumeEmulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).ResumeEmulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/resumeEmulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).ResumeEmulator(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_GetVmState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).GetVmState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/getVmState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).GetVmState(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_ListSystemImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).ListSystemImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/listSystemImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).ListSystemImages(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_SaveSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).SaveSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/saveSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).SaveSnapshot(ctx, req.(*SaveSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_LoadSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).LoadSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/loadSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).LoadSnapshot(ctx, req.(*LoadSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/listSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).ListSnapshots(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/deleteSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentControllerServer).ExportSnapshot(m, &agentControllerExportSnapshotServer{stream})
+}
+
+type AgentController_ExportSnapshotServer interface {
+	Send(*ExportSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type agentControllerExportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentControllerExportSnapshotServer) Send(m *ExportSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AgentController_ImportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentControllerServer).ImportSnapshot(&agentControllerImportSnapshotServer{stream})
+}
+
+type AgentController_ImportSnapshotServer interface {
+	SendAndClose(*Snapshot) error
+	Recv() (*ImportSnapshotRequest, error)
+	grpc.ServerStream
+}
+
+type agentControllerImportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentControllerImportSnapshotServer) SendAndClose(m *Snapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentControllerImportSnapshotServer) Recv() (*ImportSnapshotRequest, error) {
+	m := new(ImportSnapshotRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AgentController_ListDisplays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).ListDisplays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/listDisplays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).ListDisplays(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_GetScreenshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).GetScreenshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/getScreenshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).GetScreenshot(ctx, req.(*ScreenshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AgentController_StreamDisplay_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamDisplayRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -478,8 +1234,119 @@ func (x *agentControllerStreamDisplayServer) Send(m *DisplayFrame) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AgentController_RequestKeyframe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestKeyframeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).RequestKeyframe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/requestKeyframe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).RequestKeyframe(ctx, req.(*RequestKeyframeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_StartRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).StartRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/startRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).StartRecording(ctx, req.(*StartRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).StopRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/stopRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).StopRecording(ctx, req.(*StopRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_ListRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).ListRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/listRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).ListRecordings(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentController_DownloadRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadRecordingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentControllerServer).DownloadRecording(m, &agentControllerDownloadRecordingServer{stream})
+}
+
+type AgentController_DownloadRecordingServer interface {
+	Send(*DownloadRecordingResponse) error
+	grpc.ServerStream
+}
+
+type agentControllerDownloadRecordingServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentControllerDownloadRecordingServer) Send(m *DownloadRecordingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AgentController_DeleteRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).DeleteRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/deleteRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).DeleteRecording(ctx, req.(*DeleteRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AgentController_StreamSysLog_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+	m := new(StreamSysLogRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -499,6 +1366,27 @@ func (x *agentControllerStreamSysLogServer) Send(m *SysLogEntry) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AgentController_StreamEmulatorLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEmulatorLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentControllerServer).StreamEmulatorLog(m, &agentControllerStreamEmulatorLogServer{stream})
+}
+
+type AgentController_StreamEmulatorLogServer interface {
+	Send(*EmulatorLogEntry) error
+	grpc.ServerStream
+}
+
+type agentControllerStreamEmulatorLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentControllerStreamEmulatorLogServer) Send(m *EmulatorLogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AgentController_SendInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InputRequest)
 	if err := dec(in); err != nil {
@@ -626,6 +1514,50 @@ func (x *agentControllerPushFileServer) Recv() (*PushFileRequest, error) {
 	return m, nil
 }
 
+func _AgentController_UploadImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentControllerServer).UploadImage(&agentControllerUploadImageServer{stream})
+}
+
+type AgentController_UploadImageServer interface {
+	SendAndClose(*UploadedImage) error
+	Recv() (*UploadImageRequest, error)
+	grpc.ServerStream
+}
+
+type agentControllerUploadImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentControllerUploadImageServer) SendAndClose(m *UploadedImage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentControllerUploadImageServer) Recv() (*UploadImageRequest, error) {
+	m := new(UploadImageRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AgentController_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControllerServer).DeleteImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AgentController/deleteImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControllerServer).DeleteImage(ctx, req.(*DeleteImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AgentController_ServiceDesc is the grpc.ServiceDesc for AgentController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -641,6 +1573,70 @@ var AgentController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "stopEmulator",
 			Handler:    _AgentController_StopEmulator_Handler,
 		},
+		{
+			MethodName: "restartEmulator",
+			Handler:    _AgentController_RestartEmulator_Handler,
+		},
+		{
+			MethodName: "pauseEmulator",
+			Handler:    _AgentController_PauseEmulator_Handler,
+		},
+		{
+			MethodName: "resumeEmulator",
+			Handler:    _AgentController_ResumeEmulator_Handler,
+		},
+		{
+			MethodName: "getVmState",
+			Handler:    _AgentController_GetVmState_Handler,
+		},
+		{
+			MethodName: "listSystemImages",
+			Handler:    _AgentController_ListSystemImages_Handler,
+		},
+		{
+			MethodName: "saveSnapshot",
+			Handler:    _AgentController_SaveSnapshot_Handler,
+		},
+		{
+			MethodName: "loadSnapshot",
+			Handler:    _AgentController_LoadSnapshot_Handler,
+		},
+		{
+			MethodName: "listSnapshots",
+			Handler:    _AgentController_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "deleteSnapshot",
+			Handler:    _AgentController_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "listDisplays",
+			Handler:    _AgentController_ListDisplays_Handler,
+		},
+		{
+			MethodName: "getScreenshot",
+			Handler:    _AgentController_GetScreenshot_Handler,
+		},
+		{
+			MethodName: "requestKeyframe",
+			Handler:    _AgentController_RequestKeyframe_Handler,
+		},
+		{
+			MethodName: "startRecording",
+			Handler:    _AgentController_StartRecording_Handler,
+		},
+		{
+			MethodName: "stopRecording",
+			Handler:    _AgentController_StopRecording_Handler,
+		},
+		{
+			MethodName: "listRecordings",
+			Handler:    _AgentController_ListRecordings_Handler,
+		},
+		{
+			MethodName: "deleteRecording",
+			Handler:    _AgentController_DeleteRecording_Handler,
+		},
 		{
 			MethodName: "sendInput",
 			Handler:    _AgentController_SendInput_Handler,
@@ -653,6 +1649,10 @@ var AgentController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "statFile",
 			Handler:    _AgentController_StatFile_Handler,
 		},
+		{
+			MethodName: "deleteImage",
+			Handler:    _AgentController_DeleteImage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -660,16 +1660,36 @@ var AgentController_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _AgentController_StreamState_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "exportSnapshot",
+			Handler:       _AgentController_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "importSnapshot",
+			Handler:       _AgentController_ImportSnapshot_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "streamDisplay",
 			Handler:       _AgentController_StreamDisplay_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "downloadRecording",
+			Handler:       _AgentController_DownloadRecording_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "streamSysLog",
 			Handler:       _AgentController_StreamSysLog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "streamEmulatorLog",
+			Handler:       _AgentController_StreamEmulatorLog_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "openShell",
 			Handler:       _AgentController_OpenShell_Handler,
@@ -686,6 +1706,11 @@ var AgentController_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _AgentController_PushFile_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "uploadImage",
+			Handler:       _AgentController_UploadImage_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "agent.proto",
 }
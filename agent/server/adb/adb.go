@@ -1,6 +1,7 @@
 package adb
 
 import (
+	"context"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapio"
 	"os"
@@ -10,10 +11,12 @@ import (
 
 type Adb interface {
 	StartServer() error
+	KillServer() error
 	SendCommand(cmd []byte) (RawConnection, error)
 	ExecuteCommand(cmd []byte, hasBody bool) ([]byte, error)
-	WaitForEmulator() error
+	WaitForEmulator(ctx context.Context) (string, error)
 	OpenEmulator() (RawConnection, error)
+	Shell(ctx context.Context, command string) (string, error)
 	ListDirectory(path string) ([]ListDirectoryEntry, error)
 	StatFile(path string, followLinks bool) (uint32, *FileStat, error)
 	PullFile(path string) (*PullFileStream, error)
@@ -79,3 +82,17 @@ func (s *systemImpl) StartServer() error {
 
 	return nil
 }
+
+// KillServer stops the adb server started by StartServer.
+func (s *systemImpl) KillServer() error {
+	logWriter := &zapio.Writer{
+		Log: s.log.Named("adb").Desugar(),
+	}
+
+	s.log.Info("Stopping ADB server")
+	cmd := exec.Command("/android/platform-tools/adb", "kill-server")
+	cmd.Stdout = logWriter
+	cmd.Stderr = logWriter
+
+	return cmd.Run()
+}
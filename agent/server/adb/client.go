@@ -1,6 +1,8 @@
 package adb
 
 import (
+	"context"
+	"fmt"
 	"github.com/csnewman/droidmole/agent/util"
 	"strings"
 )
@@ -36,19 +38,35 @@ func (s *systemImpl) ExecuteCommand(cmd []byte, hasBody bool) ([]byte, error) {
 	return conn.ReadHexPrefixedBlob()
 }
 
-func (s *systemImpl) WaitForEmulator() error {
+// WaitForEmulator blocks until an emulator is connected, returning its serial.
+func (s *systemImpl) WaitForEmulator(ctx context.Context) (string, error) {
 	conn, err := s.SendCommand([]byte("host:track-devices"))
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	defer conn.Close()
 
-	// TODO: Add timeout
+	// Closing the connection unblocks any pending read
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
 	for {
 		msg, err := conn.ReadHexPrefixedBlob()
 		if err != nil {
-			return err
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+
+			return "", err
 		}
 
 		lines := util.SplitLines(string(msg))
@@ -60,7 +78,7 @@ func (s *systemImpl) WaitForEmulator() error {
 			parts := strings.Fields(line)
 
 			if parts[1] == "device" {
-				return nil
+				return parts[0], nil
 			}
 
 		}
@@ -70,3 +88,66 @@ func (s *systemImpl) WaitForEmulator() error {
 func (s *systemImpl) OpenEmulator() (RawConnection, error) {
 	return s.SendCommand([]byte("host:transport-local"))
 }
+
+// Shell protocol v2 packet ids
+const (
+	shellIdStdout = 1
+	shellIdStderr = 2
+	shellIdExit   = 3
+)
+
+// Shell runs a command on the emulator, returning its output. An error is returned if the command exits with a non-zero
+// status, or the context is cancelled before the command exits.
+func (s *systemImpl) Shell(ctx context.Context, command string) (string, error) {
+	conn, err := s.OpenEmulator()
+	if err != nil {
+		return "", err
+	}
+
+	defer conn.Close()
+
+	// Closing the connection unblocks any pending read
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	err = conn.SendCommand([]byte("shell,v2,raw:" + command))
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
+		return "", err
+	}
+
+	var output strings.Builder
+
+	for {
+		id, blob, err := conn.ReadShellBlob()
+		if err != nil {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+
+			return "", err
+		}
+
+		switch id {
+		case shellIdStdout, shellIdStderr:
+			output.Write(blob)
+		case shellIdExit:
+			if len(blob) > 0 && blob[0] != 0 {
+				return output.String(), fmt.Errorf("command exited with status %d", blob[0])
+			}
+
+			return output.String(), nil
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"regexp"
 )
 
 type agentControllerServer struct {
@@ -18,16 +19,24 @@ type agentControllerServer struct {
 	server *Server
 }
 
-func (s *agentControllerServer) StreamState(e *empty.Empty, server protocol.AgentController_StreamStateServer) error {
+func (s *agentControllerServer) StreamState(request *protocol.StreamStateRequest, server protocol.AgentController_StreamStateServer) error {
 	listener := s.server.stateBroadcaster.Listener()
 
+	var lastSequence uint64
+
 	for {
-		state, err := listener.Wait()
+		state, err := listener.WaitContext(server.Context())
 		if err != nil {
 			s.log.Debug("stopping state stream", err)
 			return nil
 		}
 
+		if request.TransitionsOnly && state.Sequence == lastSequence {
+			continue
+		}
+
+		lastSequence = state.Sequence
+
 		err = server.Send(state)
 		if err != nil {
 			return err
@@ -36,81 +45,265 @@ func (s *agentControllerServer) StreamState(e *empty.Empty, server protocol.Agen
 }
 
 func (s *agentControllerServer) StartEmulator(_ context.Context, request *protocol.StartEmulatorRequest) (*empty.Empty, error) {
+	// Validated before locking, as checking the images reads through them
+	err := emulator.ValidateRequest(request)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
 	s.server.mu.Lock()
 	defer s.server.mu.Unlock()
 
-	if s.server.state != StateStopped && s.server.state != StateError {
+	if s.server.shuttingDown {
+		return nil, status.Errorf(codes.Unavailable, "agent is shutting down")
+	} else if s.server.state != StateStopped && s.server.state != StateError {
 		err := status.Errorf(codes.FailedPrecondition, "emulator already running")
 
 		if err != nil {
 			return nil, err
 		}
+	} else if s.server.avdBusy {
+		return nil, status.Errorf(codes.FailedPrecondition, "snapshot transfer in progress")
 	} else {
-		emu, err := emulator.Start(s.server.adb, request, s.server)
+		s.server.cancelRestart()
+		s.server.lastRequest = request
+		s.server.restartCount = 0
+		s.server.consecutiveFailures = 0
+		s.server.lastFailure = nil
 
-		if err != nil {
-			s.server.state = StateError
-			s.server.emu = nil
-			s.server.stateError = err
-		} else {
-			s.server.state = StateStarting
-			s.server.emu = emu
-			s.server.stateError = nil
-
-			s.server.broadcastState()
-		}
+		_ = s.server.startEmulator(request)
 	}
 
 	return &empty.Empty{}, nil
 }
 
+func (s *agentControllerServer) RestartEmulator(_ context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	if s.server.shuttingDown {
+		return nil, status.Errorf(codes.Unavailable, "agent is shutting down")
+	} else if s.server.state != StateStopped && s.server.state != StateError {
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator already running")
+	} else if s.server.avdBusy {
+		return nil, status.Errorf(codes.FailedPrecondition, "snapshot transfer in progress")
+	} else if s.server.lastRequest == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator has not been started")
+	}
+
+	s.server.cancelRestart()
+	s.server.consecutiveFailures = 0
+	_ = s.server.startEmulator(s.server.lastRequest)
+
+	return &empty.Empty{}, nil
+}
+
 func (s *agentControllerServer) StopEmulator(_ context.Context, request *protocol.StopEmulatorRequest) (*empty.Empty, error) {
 	s.server.mu.Lock()
 	defer s.server.mu.Unlock()
 
-	if s.server.state != StateRunning {
-		err := status.Errorf(codes.FailedPrecondition, "emulator is not running")
+	// Stopping cancels any pending restart
+	if s.server.restartTimer != nil && (s.server.state == StateStopped || s.server.state == StateError) {
+		s.server.cancelRestart()
+		s.server.state = StateStopped
+		s.server.stateError = nil
+		s.server.broadcastState()
 
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		err := s.server.emu.Stop(request)
+		return &empty.Empty{}, nil
+	}
 
-		if err != nil {
-			s.server.state = StateError
-			s.server.emu = nil
-			s.server.stateError = err
+	switch s.server.state {
+	case StateStarting, StateBooting, StateRunning, StatePaused:
+	case StateStopping:
+		// Only a forced stop can follow a graceful stop
+		if !request.ForceExit {
+			return nil, status.Errorf(codes.FailedPrecondition, "emulator is already stopping")
 		}
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator is not running")
+	}
+
+	err := s.server.stopEmulator(request)
+	if err != nil {
+		return nil, err
 	}
 
 	return &empty.Empty{}, nil
 }
 
-func (s *agentControllerServer) SendInput(_ context.Context, request *protocol.InputRequest) (*empty.Empty, error) {
+func (s *agentControllerServer) PauseEmulator(_ context.Context, _ *empty.Empty) (*empty.Empty, error) {
 	s.server.mu.Lock()
 	defer s.server.mu.Unlock()
 
+	if s.server.state != StateRunning {
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator not running")
+	}
+
+	err := s.server.emu.Pause()
+	if err != nil {
+		return nil, err
+	}
+
+	s.server.state = StatePaused
+	s.server.broadcastState()
+
+	return &empty.Empty{}, nil
+}
+
+func (s *agentControllerServer) ResumeEmulator(_ context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	if s.server.state != StatePaused {
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator not paused")
+	}
+
+	err := s.server.emu.Resume()
+	if err != nil {
+		return nil, err
+	}
+
+	s.server.state = StateRunning
+	s.server.broadcastState()
+
+	return &empty.Empty{}, nil
+}
+
+func (s *agentControllerServer) GetVmState(_ context.Context, _ *empty.Empty) (*protocol.VmState, error) {
+	var respError error
+
+	s.server.mu.Lock()
+	emu := s.server.emu
+	if s.server.state != StateRunning && s.server.state != StatePaused {
+		respError = status.Errorf(codes.FailedPrecondition, "emulator not running")
+	}
+	s.server.mu.Unlock()
+
+	if respError != nil {
+		return nil, respError
+	}
+
+	state, err := emu.GetVmState()
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocol.VmState{
+		State: state,
+	}, nil
+}
+
+func (s *agentControllerServer) ListSystemImages(_ context.Context, _ *empty.Empty) (*protocol.ListSystemImagesResponse, error) {
+	images, err := emulator.ListSystemImages()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*protocol.SystemImage
+	for _, image := range images {
+		result = append(result, image.ToProtocol())
+	}
+
+	return &protocol.ListSystemImagesResponse{
+		Images: result,
+	}, nil
+}
+
+func (s *agentControllerServer) SendInput(_ context.Context, request *protocol.InputRequest) (*empty.Empty, error) {
 	if request == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "no request given")
 	}
 
-	if s.server.state == StateRunning || s.server.state == StateStarting {
-		return &empty.Empty{}, s.server.emu.ProcessInput(*request)
+	err := s.server.sendInput(request)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, status.Errorf(codes.FailedPrecondition, "emulator not running")
+	return &empty.Empty{}, nil
 }
 
-func (s *agentControllerServer) StreamSysLog(_ *empty.Empty, server protocol.AgentController_StreamSysLogServer) error {
-	listener := s.server.syslog.Listen()
+func (s *agentControllerServer) ListDisplays(_ context.Context, _ *empty.Empty) (*protocol.ListDisplaysResponse, error) {
+	var respError error
+
+	s.server.mu.Lock()
+	emu := s.server.emu
+	if s.server.state != StateRunning {
+		respError = status.Errorf(codes.FailedPrecondition, "emulator not running")
+	}
+	s.server.mu.Unlock()
+
+	if respError != nil {
+		return nil, respError
+	}
+
+	displays, err := emu.ListDisplays()
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocol.ListDisplaysResponse{
+		Displays: displays,
+	}, nil
+}
+
+func (s *agentControllerServer) GetScreenshot(_ context.Context, request *protocol.ScreenshotRequest) (*protocol.Screenshot, error) {
+	var respError error
+
+	s.server.mu.Lock()
+	emu := s.server.emu
+	if s.server.state != StateRunning {
+		respError = status.Errorf(codes.FailedPrecondition, "emulator not running")
+	}
+	s.server.mu.Unlock()
+
+	if respError != nil {
+		return nil, respError
+	}
+
+	return emu.GetScreenshot(request)
+}
+
+func (s *agentControllerServer) StreamSysLog(request *protocol.StreamSysLogRequest, server protocol.AgentController_StreamSysLogServer) error {
+	var filter *regexp.Regexp
+	if request.Filter != "" {
+		var err error
+		filter, err = regexp.Compile(request.Filter)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+
+	listener := s.server.syslog.Listen(request.Replay)
+	defer listener.Close()
 
 	for {
-		line := listener.Recv()
+		entry, ok := listener.Next(server.Context())
+		if !ok {
+			return nil
+		}
+
+		if filter != nil && !filter.MatchString(entry.Line) {
+			continue
+		}
+
+		msg := &protocol.SysLogEntry{
+			Line:    entry.Line,
+			Time:    uint64(entry.Time.UnixMilli()),
+			Message: entry.Message,
+			Dropped: listener.Dropped(),
+		}
+
+		if entry.KernelTime != nil {
+			kernelTime := entry.KernelTime.Seconds()
+			msg.KernelTime = &kernelTime
+		}
+
+		if entry.Level != nil {
+			level := protocol.SysLogEntry_Level(*entry.Level)
+			msg.Level = &level
+		}
 
-		err := server.Send(&protocol.SysLogEntry{
-			Line: line,
-		})
+		err := server.Send(msg)
 		if err != nil {
 			return err
 		}
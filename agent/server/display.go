@@ -1,16 +1,27 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"github.com/csnewman/droidmole/agent/protocol"
 	"github.com/csnewman/droidmole/agent/server/emulator"
+	"github.com/csnewman/droidmole/agent/server/encoder"
 	"github.com/csnewman/droidmole/agent/util/broadcaster"
-	"github.com/csnewman/droidmole/agent/util/vpx"
+	"github.com/golang/protobuf/ptypes/empty"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync/atomic"
 	"time"
 )
 
 func (s *agentControllerServer) StreamDisplay(request *protocol.StreamDisplayRequest, sds protocol.AgentController_StreamDisplayServer) error {
-	frameListener := s.server.frameBroadcaster.Listener()
+	frames, ok := s.server.frameBroadcaster(request.Display)
+	if !ok {
+		return status.Errorf(codes.NotFound, "display not found")
+	}
+
+	frameListener := frames.Listener()
 
 	dp := &displayProcessor{
 		log:           s.log,
@@ -19,56 +30,129 @@ func (s *agentControllerServer) StreamDisplay(request *protocol.StreamDisplayReq
 		request:       request,
 	}
 
-	err := dp.processFrame()
+	s.server.registerDisplayStream(dp)
+	defer s.server.unregisterDisplayStream(dp)
+	defer dp.freeEncoder()
+
+	// The context is cancelled once the client goes away, releasing the encoder
+	ctx := sds.Context()
+
+	err := dp.processFrame(ctx)
 	if err != nil {
-		s.log.Error("Error streaming display: ", err)
-		return err
+		return s.displayStreamError(ctx, err)
 	}
 
 	if request.MaxFps == 0 {
 		for {
-			err := dp.processFrame()
+			err := dp.processFrame(ctx)
 			if err != nil {
-				s.log.Error("Error streaming display: ", err)
-				return err
+				return s.displayStreamError(ctx, err)
 			}
-
 		}
 	} else {
 		ticker := time.NewTicker(1 * time.Second / time.Duration(request.MaxFps))
-		// TODO: Implement
-		done := make(chan bool)
+		defer ticker.Stop()
 
 		for {
 			select {
-			case <-done:
+			case <-ctx.Done():
+				s.log.Debug("stopping display stream", ctx.Err())
 				return nil
 			case <-ticker.C:
-				err := dp.processFrame()
+				err := dp.processFrame(ctx)
 				if err != nil {
-					s.log.Error("Error streaming display: ", err)
-					return err
+					return s.displayStreamError(ctx, err)
 				}
 			}
 		}
 	}
 }
 
+// displayStreamError handles an error ending a display stream. Errors caused by the client going away or the display
+// closing are not reported.
+func (s *agentControllerServer) displayStreamError(ctx context.Context, err error) error {
+	if ctx.Err() != nil || errors.Is(err, broadcaster.Closed) {
+		s.log.Debug("stopping display stream", err)
+		return nil
+	}
+
+	s.log.Error("Error streaming display: ", err)
+	return err
+}
+
+func (s *agentControllerServer) RequestKeyframe(_ context.Context, request *protocol.RequestKeyframeRequest) (*empty.Empty, error) {
+	s.server.displayMu.Lock()
+	dp, ok := s.server.displayStreams[request.StreamId]
+	s.server.displayMu.Unlock()
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "display stream not found")
+	}
+
+	dp.requestKeyframe()
+
+	return &empty.Empty{}, nil
+}
+
+func (s *Server) registerDisplayStream(dp *displayProcessor) {
+	s.displayMu.Lock()
+	defer s.displayMu.Unlock()
+
+	s.nextDisplayStreamId++
+	dp.id = s.nextDisplayStreamId
+	s.displayStreams[dp.id] = dp
+}
+
+func (s *Server) unregisterDisplayStream(dp *displayProcessor) {
+	s.displayMu.Lock()
+	defer s.displayMu.Unlock()
+
+	delete(s.displayStreams, dp.id)
+}
+
 type displayProcessor struct {
-	log           *zap.SugaredLogger
-	sds           protocol.AgentController_StreamDisplayServer
-	frameListener *broadcaster.Listener[*emulator.Frame]
-	img           *vpx.Image
-	codecCtx      *vpx.CodecCtx
-	request       *protocol.StreamDisplayRequest
-	width         uint32
-	height        uint32
-	frameCount    uint32
-	lastKeyframe  time.Time
+	log               *zap.SugaredLogger
+	sds               protocol.AgentController_StreamDisplayServer
+	frameListener     *broadcaster.Listener[*emulator.Frame]
+	enc               encoder.Encoder
+	request           *protocol.StreamDisplayRequest
+	width             uint32
+	height            uint32
+	frameCount        uint32
+	lastKeyframe      time.Time
+	id                uint64
+	keyframeRequested atomic.Bool
+}
+
+// requestKeyframe forces the next frame to be encoded as a keyframe. The current frame is re-encoded if the display
+// has not changed.
+func (p *displayProcessor) requestKeyframe() {
+	p.keyframeRequested.Store(true)
+	p.frameListener.Wake()
+}
+
+func (p *displayProcessor) freeEncoder() {
+	if p.enc != nil {
+		p.enc.Free()
+		p.enc = nil
+	}
+}
+
+func (p *displayProcessor) newEncoder() (encoder.Encoder, error) {
+	switch p.request.Format {
+	case protocol.StreamDisplayRequest_RGB888:
+		return encoder.NewRaw(), nil
+	case protocol.StreamDisplayRequest_VP8:
+		return encoder.NewVP8(p.width, p.height)
+	case protocol.StreamDisplayRequest_H264:
+		return encoder.NewH264(p.width, p.height)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown format")
+	}
 }
 
-func (p *displayProcessor) processFrame() error {
-	frame, err := p.frameListener.Wait()
+func (p *displayProcessor) processFrame(ctx context.Context) error {
+	frame, err := p.frameListener.WaitContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -83,19 +167,14 @@ func (p *displayProcessor) processFrame() error {
 		p.lastKeyframe = now
 		p.log.Info("Changing stream resolution ", p.width, "x", p.height)
 
-		if p.img != nil {
-			p.img.Free()
-		}
-
-		if p.codecCtx != nil {
-			p.codecCtx.Free()
-		}
+		p.freeEncoder()
 
 		return p.sds.Send(&protocol.DisplayFrame{
 			Keyframe: true,
 			Width:    uint32(0),
 			Height:   uint32(0),
 			Data:     []byte{},
+			StreamId: p.id,
 		})
 	}
 
@@ -108,77 +187,40 @@ func (p *displayProcessor) processFrame() error {
 		p.log.Info("Changing stream resolution ", p.width, "x", p.height)
 
 		// Reconfigure encoder
-		if p.img != nil {
-			p.img.Free()
-		}
-
-		if p.codecCtx != nil {
-			p.codecCtx.Free()
-		}
-
-		// TODO: Check memory freeing
-		vp8 := vpx.VP8Iface()
-
-		encCfg := vpx.NewCodecEncCfg()
-		err = encCfg.Default(vp8)
-		if err != nil {
-			return err
-		}
+		p.freeEncoder()
 
-		encCfg.SetGW(uint(p.width))
-		encCfg.SetGH(uint(p.height))
-		encCfg.SetRcTargetBitrate(1_000)
-		encCfg.SetGErrorResilient(1)
-		encCfg.SetGTimebase(1, 60)
-
-		p.codecCtx = vpx.NewCodecCtx()
-		err = p.codecCtx.EncInit(vp8, encCfg, 0)
+		p.enc, err = p.newEncoder()
 		if err != nil {
 			return err
 		}
-
-		p.img = vpx.NullImage().Alloc(vpx.ImageFormatI420, uint32(p.width), uint32(p.height), 0)
-		if p.img == nil {
-			p.log.Fatal("failed to create img")
-		}
 	}
 
-	// Convert frame to YUV
-	data := vpx.RgbToYuv(frame.Data, p.width, p.height)
-	p.img.Read(data)
-
-	// Determine whether to encode a keyframe
-	keyframe := p.frameCount == 0
+	// Determine whether to encode a keyframe. Raw frames are always keyframes
+	keyframe := p.frameCount == 0 || p.request.Format == protocol.StreamDisplayRequest_RGB888
 
-	if p.request.KeyframeInterval != 0 && uint32(now.Sub(p.lastKeyframe).Milliseconds()) >= p.request.KeyframeInterval {
+	if p.keyframeRequested.Swap(false) {
 		keyframe = true
 		p.lastKeyframe = now
 	}
 
-	flags := vpx.EFlagNone
-	if keyframe {
-		flags = vpx.EFlagForceKF
+	if p.request.KeyframeInterval != 0 && uint32(now.Sub(p.lastKeyframe).Milliseconds()) >= p.request.KeyframeInterval {
+		keyframe = true
+		p.lastKeyframe = now
 	}
 
 	// Encode
-	err = p.codecCtx.Encode(p.img, vpx.CodecPts(p.frameCount), uint64(1), flags, vpx.DLRealtime)
+	packets, err := p.enc.Encode(frame.Data, keyframe)
 	if err != nil {
-		p.log.Fatal("scr error", err)
+		return err
 	}
 
-	// Extract packets
-	var iter vpx.CodecIter
-	for {
-		pkt := p.codecCtx.GetFrameBuffer(&iter)
-		if pkt == nil {
-			break
-		}
-
+	for _, pkt := range packets {
 		err := p.sds.Send(&protocol.DisplayFrame{
 			Keyframe: keyframe,
 			Width:    p.width,
 			Height:   p.height,
 			Data:     pkt,
+			StreamId: p.id,
 		})
 		if err != nil {
 			return err
@@ -0,0 +1,411 @@
+package emulator
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"gopkg.in/ini.v1"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	archiveVersion   = 1
+	manifestEntry    = "manifest.json"
+	checksumsEntry   = "checksums.json"
+	archiveAvdPrefix = "avd/"
+)
+
+var (
+	// ErrIncompatibleSnapshot signifies an imported snapshot was taken with a different emulator or system image.
+	ErrIncompatibleSnapshot = errors.New("incompatible snapshot")
+
+	// ErrCorruptSnapshot signifies an imported snapshot archive failed its integrity checks.
+	ErrCorruptSnapshot = errors.New("corrupt snapshot archive")
+)
+
+// snapshotManifest describes the environment a snapshot archive was exported from. It is the first entry of the
+// archive, allowing compatibility to be checked before any data is extracted.
+type snapshotManifest struct {
+	Version         int           `json:"version"`
+	Snapshot        string        `json:"snapshot"`
+	EmulatorVersion string        `json:"emulatorVersion"`
+	SystemImage     manifestImage `json:"systemImage"`
+}
+
+type manifestImage struct {
+	Name        string `json:"name"`
+	ApiLevel    uint32 `json:"apiLevel"`
+	Abi         string `json:"abi"`
+	Tag         string `json:"tag"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// ExportSnapshot writes a gzip compressed tar archive of a snapshot and the AVD data it depends on. Must only be used
+// while the emulator is stopped.
+func ExportSnapshot(name string, w io.Writer) error {
+	_, err := DescribeSnapshot(name)
+	if err != nil {
+		return err
+	}
+
+	image, err := avdSystemImage()
+	if err != nil {
+		return err
+	}
+
+	version, err := Version()
+	if err != nil {
+		return err
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	err = writeJsonEntry(tw, manifestEntry, &snapshotManifest{
+		Version:         archiveVersion,
+		Snapshot:        name,
+		EmulatorVersion: version,
+		SystemImage: manifestImage{
+			Name:        image.Name,
+			ApiLevel:    image.ApiLevel,
+			Abi:         image.Abi,
+			Tag:         image.Tag,
+			Fingerprint: image.Fingerprint,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	checksums := make(map[string]string)
+
+	err = filepath.WalkDir(avdDir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(avdDir, file)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if entry.IsDir() {
+			// Only the exported snapshot is included
+			if path.Dir(rel) == "snapshots" && path.Base(rel) != name {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !entry.Type().IsRegular() || strings.HasSuffix(rel, ".lock") {
+			return nil
+		}
+
+		checksum, err := writeFileEntry(tw, archiveAvdPrefix+rel, file)
+		if err != nil {
+			return err
+		}
+
+		checksums[rel] = checksum
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = writeJsonEntry(tw, checksumsEntry, checksums)
+	if err != nil {
+		return err
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+// avdSystemImage returns the system image the AVD was last booted with.
+func avdSystemImage() (*SystemImage, error) {
+	cfg, err := ini.Load(filepath.Join(avdDir, "config.ini"))
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Clean(cfg.Section("").Key("image.sysdir.1").String())
+
+	images, err := ListSystemImages()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, image := range images {
+		if image.Dir == dir {
+			return image, nil
+		}
+	}
+
+	return nil, ErrSystemImageNotFound
+}
+
+func writeJsonEntry(tw *tar.Writer, name string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	err = tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(data)),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tw.Write(data)
+	return err
+}
+
+func writeFileEntry(tw *tar.Writer, name string, file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return "", err
+	}
+
+	header.Name = name
+
+	err = tw.WriteHeader(header)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+
+	_, err = io.Copy(io.MultiWriter(tw, hash), f)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// ImportSnapshot replaces the AVD data with the contents of a snapshot archive, once the archive has been verified.
+// Must only be used while the emulator is stopped.
+func ImportSnapshot(r io.Reader) (*protocol.Snapshot, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptSnapshot, err)
+	}
+
+	tr := tar.NewReader(gr)
+
+	header, err := tr.Next()
+	if err != nil || header.Name != manifestEntry {
+		return nil, fmt.Errorf("%w: missing manifest", ErrCorruptSnapshot)
+	}
+
+	manifest := &snapshotManifest{}
+
+	err = json.NewDecoder(tr).Decode(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid manifest: %v", ErrCorruptSnapshot, err)
+	}
+
+	err = checkCompatibility(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract alongside the AVD, so the data can be swapped into place with a rename
+	staging, err := os.MkdirTemp(filepath.Dir(avdDir), ".import-")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(staging)
+
+	err = extractArchive(tr, staging)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = os.Stat(filepath.Join(staging, "snapshots", manifest.Snapshot, "snapshot.pb"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: snapshot %s missing", ErrCorruptSnapshot, manifest.Snapshot)
+	}
+
+	err = replaceAvd(staging)
+	if err != nil {
+		return nil, err
+	}
+
+	return DescribeSnapshot(manifest.Snapshot)
+}
+
+func checkCompatibility(manifest *snapshotManifest) error {
+	if manifest.Version != archiveVersion {
+		return fmt.Errorf("%w: unsupported archive version %d", ErrIncompatibleSnapshot, manifest.Version)
+	}
+
+	err := ValidateSnapshotName(manifest.Snapshot)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCorruptSnapshot, err)
+	}
+
+	version, err := Version()
+	if err != nil {
+		return err
+	}
+
+	if manifest.EmulatorVersion != version {
+		return fmt.Errorf(
+			"%w: taken with emulator %s, but %s is installed",
+			ErrIncompatibleSnapshot,
+			manifest.EmulatorVersion,
+			version,
+		)
+	}
+
+	image, err := FindSystemImage(manifest.SystemImage.Name)
+	if errors.Is(err, ErrSystemImageNotFound) {
+		return fmt.Errorf("%w: system image %s is not installed", ErrIncompatibleSnapshot, manifest.SystemImage.Name)
+	} else if err != nil {
+		return err
+	}
+
+	if image.Abi != manifest.SystemImage.Abi || image.Fingerprint != manifest.SystemImage.Fingerprint {
+		return fmt.Errorf(
+			"%w: taken with system image build %s, but %s is installed",
+			ErrIncompatibleSnapshot,
+			manifest.SystemImage.Fingerprint,
+			image.Fingerprint,
+		)
+	}
+
+	return nil
+}
+
+// extractArchive extracts the AVD entries into the given directory, verifying them against the trailing checksums.
+func extractArchive(tr *tar.Reader, dir string) error {
+	actual := make(map[string]string)
+
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCorruptSnapshot, err)
+		}
+
+		if header.Name == checksumsEntry {
+			expected := make(map[string]string)
+
+			err = json.NewDecoder(tr).Decode(&expected)
+			if err != nil {
+				return fmt.Errorf("%w: invalid checksums: %v", ErrCorruptSnapshot, err)
+			}
+
+			if len(expected) != len(actual) {
+				return fmt.Errorf("%w: expected %d files, got %d", ErrCorruptSnapshot, len(expected), len(actual))
+			}
+
+			for name, checksum := range expected {
+				if actual[name] != checksum {
+					return fmt.Errorf("%w: checksum mismatch for %s", ErrCorruptSnapshot, name)
+				}
+			}
+
+			return nil
+		}
+
+		rel := strings.TrimPrefix(header.Name, archiveAvdPrefix)
+		if rel == header.Name || header.Typeflag != tar.TypeReg || !fs.ValidPath(rel) {
+			return fmt.Errorf("%w: unexpected entry %s", ErrCorruptSnapshot, header.Name)
+		}
+
+		checksum, err := extractFile(tr, filepath.Join(dir, filepath.FromSlash(rel)), header)
+		if err != nil {
+			return err
+		}
+
+		actual[rel] = checksum
+	}
+}
+
+func extractFile(r io.Reader, file string, header *tar.Header) (string, error) {
+	err := os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_EXCL|os.O_WRONLY, header.FileInfo().Mode().Perm())
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	hash := sha256.New()
+
+	_, err = io.Copy(io.MultiWriter(f, hash), r)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrCorruptSnapshot, err)
+	}
+
+	err = f.Close()
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), os.Chtimes(file, header.ModTime, header.ModTime)
+}
+
+// replaceAvd swaps the AVD directory for the given directory.
+func replaceAvd(dir string) error {
+	backup := avdDir + ".old"
+
+	err := os.RemoveAll(backup)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(avdDir, backup)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	err = os.Rename(dir, avdDir)
+	if err != nil {
+		// Restore the previous data
+		_ = os.Rename(backup, avdDir)
+		return err
+	}
+
+	return os.RemoveAll(backup)
+}
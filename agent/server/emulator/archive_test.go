@@ -0,0 +1,46 @@
+package emulator
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"github.com/matryer/is"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExtractArchive(t *testing.T) {
+	is := is.New(t)
+
+	src := t.TempDir()
+	is.NoErr(os.MkdirAll(filepath.Join(src, "snapshots", "warm"), 0755))
+	is.NoErr(os.WriteFile(filepath.Join(src, "snapshots", "warm", "snapshot.pb"), []byte("snapshot"), 0644))
+
+	archive := func(checksums map[string]string) *tar.Reader {
+		b := &bytes.Buffer{}
+		tw := tar.NewWriter(b)
+
+		checksum, err := writeFileEntry(tw, "avd/snapshots/warm/snapshot.pb", filepath.Join(src, "snapshots", "warm", "snapshot.pb"))
+		is.NoErr(err)
+
+		if checksums == nil {
+			checksums = map[string]string{"snapshots/warm/snapshot.pb": checksum}
+		}
+
+		is.NoErr(writeJsonEntry(tw, checksumsEntry, checksums))
+		is.NoErr(tw.Close())
+
+		return tar.NewReader(b)
+	}
+
+	dst := t.TempDir()
+	is.NoErr(extractArchive(archive(nil), dst))
+
+	data, err := os.ReadFile(filepath.Join(dst, "snapshots", "warm", "snapshot.pb"))
+	is.NoErr(err)
+	is.Equal(string(data), "snapshot")
+
+	err = extractArchive(archive(map[string]string{"snapshots/warm/snapshot.pb": "0000"}), t.TempDir())
+	is.True(errors.Is(err, ErrCorruptSnapshot))
+}
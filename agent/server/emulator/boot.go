@@ -0,0 +1,147 @@
+package emulator
+
+import (
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/syslog"
+	"go.uber.org/zap"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const bootPollInterval = time.Second
+
+// bootTracker records the furthest boot stage reached. Stages are only ever advanced, with any skipped stages being
+// reported at the same time, as fast boots and snapshot loads do not always produce every marker.
+// The stage is atomic, so it can be read while the monitor is locked.
+type bootTracker struct {
+	mu      sync.Mutex
+	stage   atomic.Int32
+	monitor Monitor
+	log     *zap.SugaredLogger
+}
+
+func (b *bootTracker) advance(stage protocol.AgentState_BootStage) {
+	b.mu.Lock()
+
+	current := b.current()
+	if stage <= current {
+		b.mu.Unlock()
+		return
+	}
+
+	var reached []protocol.AgentState_BootStage
+	for current < stage {
+		current++
+		reached = append(reached, current)
+	}
+
+	b.stage.Store(int32(stage))
+	b.mu.Unlock()
+
+	// The monitor is notified without the tracker locked, as the monitor may itself read the tracker
+	now := time.Now()
+
+	for _, s := range reached {
+		b.log.Info("Boot stage reached: ", s)
+		b.monitor.OnEmulatorBootStage(s, now)
+	}
+}
+
+func (b *bootTracker) current() protocol.AgentState_BootStage {
+	return protocol.AgentState_BootStage(b.stage.Load())
+}
+
+func (b *bootTracker) reached(stage protocol.AgentState_BootStage) bool {
+	return b.current() >= stage
+}
+
+// kernelBootStage returns the boot stage implied by a kernel log line.
+func kernelBootStage(line string) protocol.AgentState_BootStage {
+	switch {
+	case strings.Contains(line, "starting service 'zygote"):
+		return protocol.AgentState_ZYGOTE
+	case strings.Contains(line, "Run /init as init process"), strings.Contains(line, "init first stage started"):
+		return protocol.AgentState_INIT
+	default:
+		return protocol.AgentState_KERNEL
+	}
+}
+
+// watchKernelLog advances the boot stage from the kernel log, until zygote has started, and checks the kernel log for
+// fatal events until the emulator stops.
+func (e *Emulator) watchKernelLog(sysLog *syslog.SysLog) {
+	listener := sysLog.Listen(false)
+	defer listener.Close()
+
+	excerpt := &logExcerpt{}
+
+	for {
+		entry, ok := listener.Next(e.ctx)
+		if !ok {
+			return
+		}
+
+		if !e.boot.reached(protocol.AgentState_ZYGOTE) {
+			e.boot.advance(kernelBootStage(entry.Message))
+		}
+
+		excerpt.add(entry.Line)
+		e.fatal.process(entry.Line, excerpt)
+	}
+}
+
+// waitForBoot polls the device until android has finished booting and the package manager is available. Returns
+// false if the emulator is stopping or exited first.
+func (e *Emulator) waitForBoot() bool {
+	ticker := time.NewTicker(bootPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if e.pollBootStage() {
+			return true
+		}
+
+		select {
+		case <-e.ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollBootStage advances the boot stage from the device properties, returning true once booting has completed.
+func (e *Emulator) pollBootStage() bool {
+	// Any shell response implies the kernel and init are up
+	out, err := e.adb.Shell(e.ctx, "getprop init.svc.zygote")
+	if err != nil {
+		return false
+	}
+
+	e.boot.advance(protocol.AgentState_INIT)
+
+	if strings.TrimSpace(out) == "running" {
+		e.boot.advance(protocol.AgentState_ZYGOTE)
+	}
+
+	out, err = e.adb.Shell(e.ctx, "pidof system_server")
+	if err == nil && strings.TrimSpace(out) != "" {
+		e.boot.advance(protocol.AgentState_SYSTEM_SERVER)
+	}
+
+	out, err = e.adb.Shell(e.ctx, "getprop sys.boot_completed")
+	if err != nil || strings.TrimSpace(out) != "1" {
+		return false
+	}
+
+	// The package manager may still be unavailable for a short time after boot completes
+	out, err = e.adb.Shell(e.ctx, "pm path android")
+	if err != nil || !strings.Contains(out, "package:") {
+		return false
+	}
+
+	e.boot.advance(protocol.AgentState_BOOT_COMPLETED)
+
+	return true
+}
@@ -1,7 +1,6 @@
 package emulator
 
 import (
-	"errors"
 	"fmt"
 	"github.com/csnewman/droidmole/agent/protocol"
 	"gopkg.in/ini.v1"
@@ -14,29 +13,7 @@ const (
 	ARM64V8A = "arm64-v8a"
 )
 
-func GetImageCpu() (string, error) {
-	cfg, err := ini.Load("/android/system-image/build.prop")
-	if err != nil {
-		return "", err
-	}
-
-	sect := cfg.Section("")
-
-	if !sect.HasKey("ro.product.cpu.abi") {
-		return "", errors.New("ro.product.cpu.abi missing")
-	}
-
-	key, err := sect.GetKey("ro.product.cpu.abi")
-	if err != nil {
-		return "", err
-	}
-
-	abi := key.Value()
-
-	return abi, nil
-}
-
-func createConfig(request *protocol.StartEmulatorRequest) (*ini.File, error) {
+func createConfig(request *protocol.StartEmulatorRequest, image *SystemImage) (*ini.File, error) {
 	cfg := ini.Empty()
 	section := cfg.Section("")
 
@@ -48,29 +25,50 @@ func createConfig(request *protocol.StartEmulatorRequest) (*ini.File, error) {
 	// Hardware
 	section.Key("hw.ramSize").SetValue(strconv.FormatInt(int64(request.GetRamSize()), 10))
 	section.Key("hw.cpu.ncore").SetValue(strconv.FormatInt(int64(request.GetCoreCount()), 10))
-	// TODO: Replace
-	section.Key("disk.dataPartition.size").SetValue("512MB")
+
+	dataPartitionSize := request.GetDataPartitionSize()
+	if dataPartitionSize == 0 {
+		dataPartitionSize = defaultDataPartitionSize
+	}
+
+	section.Key("disk.dataPartition.size").SetValue(fmt.Sprintf("%dMB", dataPartitionSize))
 	section.Key("fastboot.forceColdBoot").SetValue("no")
 	section.Key("hw.accelerometer").SetValue("yes")
 	section.Key("hw.audioInput").SetValue("yes")
 	section.Key("hw.battery").SetValue("yes")
-	section.Key("hw.camera.back").SetValue("emulated")
-	section.Key("hw.camera.front").SetValue("emulated")
+	section.Key("hw.camera.back").SetValue(cameraMode(request.GetCameraBack()))
+	section.Key("hw.camera.front").SetValue(cameraMode(request.GetCameraFront()))
 	section.Key("hw.dPad").SetValue("no")
 	section.Key("hw.device.hash2").SetValue("MD5:bc5032b2a871da511332401af3ac6bb0")
 	section.Key("hw.device.manufacturer").SetValue("Google")
 	section.Key("hw.gps").SetValue("yes")
 	section.Key("hw.gpu.enabled").SetValue("yes")
-	section.Key("hw.gpu.mode").SetValue("auto")
-	section.Key("hw.initialOrientation").SetValue("Portrait")
-	section.Key("hw.keyboard").SetValue("yes")
+	if request.GpuMode == nil {
+		section.Key("hw.gpu.mode").SetValue("auto")
+	} else {
+		section.Key("hw.gpu.mode").SetValue(gpuMode(request.GetGpuMode()))
+	}
+
+	if request.GetOrientation() == protocol.StartEmulatorRequest_LANDSCAPE {
+		section.Key("hw.initialOrientation").SetValue("Landscape")
+	} else {
+		section.Key("hw.initialOrientation").SetValue("Portrait")
+	}
+
+	section.Key("hw.keyboard").SetValue(yesNo(request.Keyboard == nil || request.GetKeyboard()))
 	section.Key("hw.mainKeys").SetValue("no")
 	section.Key("hw.sensors.orientation").SetValue("yes")
 	section.Key("hw.sensors.proximity").SetValue("yes")
 	section.Key("hw.trackBall").SetValue("no")
-	section.Key("runtime.network.latency").SetValue("none")
-	section.Key("runtime.network.speed").SetValue("full")
-	// CHECK: section.Key("vm.heapSize").SetValue("512")
+
+	speed, latency := networkProfile(request.GetNetworkProfile())
+	section.Key("runtime.network.latency").SetValue(latency)
+	section.Key("runtime.network.speed").SetValue(speed)
+
+	if request.GetHeapSize() != 0 {
+		section.Key("vm.heapSize").SetValue(strconv.FormatInt(int64(request.GetHeapSize()), 10))
+	}
+
 	// CHECK: section.Key("tag.display").SetValue("Google APIs")
 
 	// Display
@@ -78,26 +76,31 @@ func createConfig(request *protocol.StartEmulatorRequest) (*ini.File, error) {
 	section.Key("hw.lcd.width").SetValue(strconv.FormatInt(int64(request.GetLcdWidth()), 10))
 	section.Key("hw.lcd.height").SetValue(strconv.FormatInt(int64(request.GetLcdHeight()), 10))
 
-	//section.Key("hw.sdCard").SetValue("yes")
-	//section.Key("sdcard.size").SetValue("512M")
+	// The card image is created before each boot
+	if request.GetSdcardSize() != 0 {
+		section.Key("hw.sdCard").SetValue("yes")
+		section.Key("sdcard.size").SetValue(fmt.Sprintf("%dM", request.GetSdcardSize()))
+	} else {
+		section.Key("hw.sdCard").SetValue("no")
+	}
 
-	abi, err := GetImageCpu()
-	if err != nil {
-		return nil, err
+	// Passthrough properties take precedence over the defaults above
+	for key, value := range request.GetHardwareProperties() {
+		section.Key(key).SetValue(value)
 	}
 
-	section.Key("abi.type").SetValue(abi)
+	section.Key("abi.type").SetValue(image.Abi)
 
-	switch abi {
+	switch image.Abi {
 	case X8664:
 		section.Key("hw.cpu.arch").SetValue(X8664)
 	case ARM64V8A:
 		section.Key("hw.cpu.arch").SetValue(ARM64)
 	default:
-		return nil, fmt.Errorf("unknown abi %s", abi)
+		return nil, fmt.Errorf("unknown abi %s", image.Abi)
 	}
 
-	section.Key("image.sysdir.1").SetValue("/android/system-image/")
+	section.Key("image.sysdir.1").SetValue(image.Dir + "/")
 
 	return cfg, nil
 }
@@ -0,0 +1,117 @@
+package console
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const authTokenFile = ".emulator_console_auth_token"
+
+// Console represents a connection to the emulator's telnet console.
+type Console struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// Connect opens a connection to the console at the given address, authenticating if required.
+func Connect(addr string) (*Console, error) {
+	conn, err := net.DialTimeout("tcp", addr, 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Console{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+	}
+
+	banner, err := c.readResponse(5 * time.Second)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if strings.Contains(banner, "Authentication required") {
+		token, err := readAuthToken()
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		_, err = c.Command("auth "+token, 5*time.Second)
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("console authentication failed: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+func readAuthToken() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	token, err := os.ReadFile(filepath.Join(home, authTokenFile))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(token)), nil
+}
+
+// Command executes a console command, returning its output once completed.
+func (c *Console) Command(command string, timeout time.Duration) (string, error) {
+	_, err := c.conn.Write([]byte(command + "\r\n"))
+	if err != nil {
+		return "", err
+	}
+
+	return c.readResponse(timeout)
+}
+
+// readResponse reads lines until the "OK" or "KO" line terminating a response.
+func (c *Console) readResponse(timeout time.Duration) (string, error) {
+	err := c.conn.SetReadDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return "", err
+	}
+
+	var output []string
+
+	for {
+		line, err := c.reader.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+
+		if line == "OK" {
+			return strings.Join(output, "\n"), nil
+		}
+
+		if strings.HasPrefix(line, "KO") {
+			message := strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(line, "KO"), ":"))
+			if message == "" {
+				message = strings.Join(output, "\n")
+			}
+
+			return "", errors.New(message)
+		}
+
+		output = append(output, line)
+	}
+}
+
+func (c *Console) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,55 @@
+package console
+
+import (
+	"bufio"
+	"github.com/matryer/is"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConsole(t *testing.T) {
+	is := is.New(t)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	is.NoErr(err)
+	defer lis.Close()
+
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		_, _ = conn.Write([]byte("Android Console: type 'help' for a list of commands\r\nOK\r\n"))
+
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			switch strings.TrimSpace(line) {
+			case "avd snapshot list":
+				_, _ = conn.Write([]byte("List of snapshots present on all disks:\r\nOK\r\n"))
+			default:
+				_, _ = conn.Write([]byte("KO: unknown command, try 'help'\r\n"))
+			}
+		}
+	}()
+
+	c, err := Connect(lis.Addr().String())
+	is.NoErr(err)
+	defer c.Close()
+
+	out, err := c.Command("avd snapshot list", time.Second)
+	is.NoErr(err)
+	is.Equal(out, "List of snapshots present on all disks:")
+
+	_, err = c.Command("invalid", time.Second)
+	is.Equal(err.Error(), "unknown command, try 'help'")
+}
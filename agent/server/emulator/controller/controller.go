@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/csnewman/droidmole/agent/server/emulator/controller/protocol"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/tmthrgd/go-shm"
@@ -10,28 +12,29 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"log"
 	"os"
+	"path/filepath"
 	"time"
 )
 
 type Controller struct {
 	connection    *grpc.ClientConn
 	controlClient protocol.EmulatorControllerClient
+	version       string
 }
 
-func Connect(serverUrl string) (*Controller, error) {
+func Connect(ctx context.Context, serverUrl string) (*Controller, error) {
 	log.Println("Connecting to grpc")
 	var opts []grpc.DialOption
 	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	opts = append(opts, grpc.WithBlock())
 
-	conn, err := grpc.Dial(serverUrl, opts...)
+	conn, err := grpc.DialContext(ctx, serverUrl, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	controlClient := protocol.NewEmulatorControllerClient(conn)
 
-	ctx := context.Background()
 	stats, err := controlClient.GetStatus(ctx, &empty.Empty{})
 	if err != nil {
 		return nil, err
@@ -43,11 +46,28 @@ func Connect(serverUrl string) (*Controller, error) {
 	controller := &Controller{
 		connection:    conn,
 		controlClient: controlClient,
+		version:       stats.Version,
 	}
 
 	return controller, nil
 }
 
+// Version returns the emulator version reported on connection.
+func (c *Controller) Version() string {
+	return c.version
+}
+
+func (c *Controller) Close() error {
+	return c.connection.Close()
+}
+
+func (c *Controller) GetStatus() (*protocol.EmulatorStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	return c.controlClient.GetStatus(ctx, &empty.Empty{})
+}
+
 func (c *Controller) SendTouch(event protocol.TouchEvent) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
@@ -56,6 +76,14 @@ func (c *Controller) SendTouch(event protocol.TouchEvent) error {
 	return err
 }
 
+func (c *Controller) SendKey(event *protocol.KeyboardEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	_, err := c.controlClient.SendKey(ctx, event)
+	return err
+}
+
 func (c *Controller) RequestExit() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
@@ -66,14 +94,63 @@ func (c *Controller) RequestExit() error {
 	return err
 }
 
+func (c *Controller) GetVmState() (protocol.VmRunState_RunState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	state, err := c.controlClient.GetVmState(ctx, &empty.Empty{})
+	if err != nil {
+		return protocol.VmRunState_UNKNOWN, err
+	}
+
+	return state.State, nil
+}
+
+func (c *Controller) SetVmState(state protocol.VmRunState_RunState) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	_, err := c.controlClient.SetVmState(ctx, &protocol.VmRunState{
+		State: state,
+	})
+	return err
+}
+
+func (c *Controller) GetDisplayConfigurations() (*protocol.DisplayConfigurations, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	return c.controlClient.GetDisplayConfigurations(ctx, &empty.Empty{})
+}
+
+func (c *Controller) SetDisplayConfigurations(configs *protocol.DisplayConfigurations) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := c.controlClient.SetDisplayConfigurations(ctx, configs)
+	return err
+}
+
+func (c *Controller) GetScreenshot(display uint32, format protocol.ImageFormat_ImgFormat) (*protocol.Image, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return c.controlClient.GetScreenshot(ctx, &protocol.ImageFormat{
+		Format:  format,
+		Display: display,
+	})
+}
+
 type DisplayStream struct {
 	shmFile   *os.File
 	shmData   []byte
 	scrClient protocol.EmulatorController_StreamScreenshotClient
 }
 
-func (c *Controller) StreamDisplay(width int, height int) (*DisplayStream, error) {
-	shmFile, err := shm.Open("droidmole-video", unix.O_CREAT|unix.O_RDWR, 0600)
+func (c *Controller) StreamDisplay(display uint32, width int, height int) (*DisplayStream, error) {
+	handle := shmHandle(display)
+
+	shmFile, err := shm.Open(handle, unix.O_CREAT|unix.O_RDWR, 0600)
 	if err != nil {
 		return nil, err
 	}
@@ -95,10 +172,10 @@ func (c *Controller) StreamDisplay(width int, height int) (*DisplayStream, error
 		Rotation: nil,
 		Width:    uint32(width),
 		Height:   uint32(height),
-		Display:  0,
+		Display:  display,
 		Transport: &protocol.ImageTransport{
 			Channel: protocol.ImageTransport_MMAP,
-			Handle:  "droidmole-video",
+			Handle:  handle,
 		},
 		FoldedDisplay: nil,
 		DisplayMode:   0,
@@ -114,6 +191,32 @@ func (c *Controller) StreamDisplay(width int, height int) (*DisplayStream, error
 	}, nil
 }
 
+// shmHandle returns the name of the shared memory segment used to transfer frames for the given display.
+func shmHandle(display uint32) string {
+	if display == 0 {
+		return "droidmole-video"
+	}
+
+	return fmt.Sprintf("droidmole-video-%d", display)
+}
+
+// RemoveSharedMemory removes the shared memory segments used to transfer frames, which outlive the emulator.
+func RemoveSharedMemory() error {
+	paths, err := filepath.Glob(filepath.Join("/dev/shm", shmHandle(0)+"*"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		err := shm.Unlink(filepath.Base(path))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (ds *DisplayStream) GetFrame() ([]byte, error) {
 	_, err := ds.scrClient.Recv()
 	if err != nil {
@@ -2,35 +2,66 @@ package emulator
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/csnewman/droidmole/agent/protocol"
 	"github.com/csnewman/droidmole/agent/server/adb"
 	"github.com/csnewman/droidmole/agent/server/emulator/controller"
 	emuproto "github.com/csnewman/droidmole/agent/server/emulator/controller/protocol"
 	"github.com/csnewman/droidmole/agent/server/syslog"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gopkg.in/ini.v1"
 	"io"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 )
 
+const (
+	avdDir     = "/android/home/Custom.avd"
+	sdCardPath = avdDir + "/sdcard.img"
+)
+
+// errCancelled signifies the emulator exited or is being stopped while waiting for it to start.
+var errCancelled = errors.New("emulator start cancelled")
+
+// defaultStopTimeout is the time a graceful stop is given before the emulator is killed.
+const defaultStopTimeout = 30 * time.Second
+
 type Frame struct {
-	Width  uint32
-	Height uint32
-	Data   []byte
+	Display uint32
+	Width   uint32
+	Height  uint32
+	Data    []byte
 }
 
 type Monitor interface {
+	// OnEmulatorBooting is called once adb has connected, while android is still booting.
+	OnEmulatorBooting()
+
+	OnEmulatorBootStage(stage protocol.AgentState_BootStage, at time.Time)
+
 	OnEmulatorStarted()
 
+	// OnEmulatorExit is called once the emulator process has exited. err describes why the emulator exited
+	// unexpectedly, including any failure to start, after which the emulator will have been killed.
 	OnEmulatorExit(err error)
 
 	OnEmulatorFrame(frame Frame)
+
+	// OnEmulatorLog is called for each line of emulator output.
+	OnEmulatorLog(entry *protocol.EmulatorLogEntry)
+
+	// OnEmulatorFatalEvent is called when a fatal guest failure is detected in the syslog or emulator output.
+	OnEmulatorFatalEvent(event *protocol.FatalEvent)
 }
 
 type Emulator struct {
@@ -46,12 +77,49 @@ type Emulator struct {
 	errPipeWriter      *io.PipeWriter
 	exitErr            chan string
 	forceExitRequested bool
+	consoleMu          sync.Mutex
+	sysLog             *syslog.SysLog
+	boot               *bootTracker
+	fatal              *fatalDetector
+	ctx                context.Context
+	cancel             context.CancelFunc
+	stopRequested      bool
+	stopTimer          *time.Timer
+	bootTimer          *time.Timer
+	bootDeadline       time.Time
+	failure            error
+	paused             bool
+	snapshotOps        int
+	image              *SystemImage
+	startedAt          time.Time
+	bootedAt           time.Time
+	adbSerial          string
+	adbRoot            bool
 }
 
-func Start(adb adb.Adb, request *protocol.StartEmulatorRequest, monitor Monitor) (*Emulator, error) {
+// Info describes a started emulator.
+type Info struct {
+	Image     *SystemImage
+	Version   string
+	StartedAt time.Time
+	BootedAt  time.Time
+	AdbSerial string
+	AdbRoot   bool
+}
+
+func Start(
+	logger *zap.SugaredLogger,
+	adb adb.Adb,
+	sysLog *syslog.SysLog,
+	request *protocol.StartEmulatorRequest,
+	monitor Monitor,
+) (*Emulator, error) {
 	opr, opw := io.Pipe()
 	epr, epw := io.Pipe()
 
+	// Cancelled once the emulator is stopping or has exited
+	ctx, cancel := context.WithCancel(context.Background())
+
 	emu := &Emulator{
 		adb:           adb,
 		monitor:       monitor,
@@ -61,9 +129,15 @@ func Start(adb adb.Adb, request *protocol.StartEmulatorRequest, monitor Monitor)
 		errPipeReader: epr,
 		errPipeWriter: epw,
 		exitErr:       make(chan string),
+		sysLog:        sysLog,
+		boot:          &bootTracker{monitor: monitor, log: logger},
+		fatal:         newFatalDetector(monitor),
+		ctx:           ctx,
+		cancel:        cancel,
 	}
 	err := emu.startEmulator()
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
@@ -74,48 +148,83 @@ func (e *Emulator) startEmulator() error {
 	log.Println("Starting emulator")
 
 	// Create emulator directories
-	err := os.MkdirAll("/android/home/Custom.avd", 0644)
+	err := os.MkdirAll(avdDir, 0644)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile("/android/home/Custom.ini", []byte("path="+avdDir), 0644)
 	if err != nil {
 		return err
 	}
 
-	err = os.WriteFile("/android/home/Custom.ini", []byte("path=/android/home/Custom.avd"), 0644)
+	image, err := FindSystemImage(e.request.SystemImage)
 	if err != nil {
 		return err
 	}
 
+	log.Println("Using system image", image.Name)
+	e.image = image
+
+	version, err := Version()
+	if err != nil {
+		return err
+	}
+
+	log.Println("Emulator version", version)
+
 	// Create emulator config
-	cfg, err := createConfig(e.request)
+	cfg, err := createConfig(e.request, image)
 	if err != nil {
 		return err
 	}
 
-	err = cfg.SaveTo("/android/home/Custom.avd/config.ini")
+	err = cfg.SaveTo(filepath.Join(avdDir, "config.ini"))
 	if err != nil {
 		return err
 	}
 
-	cmd := exec.Command(
-		"/android/emulator/emulator",
+	// Booting from a snapshot requires the data it was taken with
+	keepData := e.request.KeepUserData || e.request.Snapshot != ""
+
+	err = createSdCard(e.request.GetSdcardSize(), keepData)
+	if err != nil {
+		return err
+	}
+
+	args := []string{
 		"-avd", "Custom",
 		"-ports", "5556,5557",
 		"-grpc", "8554",
 		"-no-window",
 		"-skip-adb-auth",
 		"-no-snapshot-save",
-		"-wipe-data",
 		"-shell-serial", fmt.Sprintf("unix:%s", syslog.SockAddr),
-		"-gpu", "swiftshader_indirect",
+		"-gpu", gpuMode(e.request.GetGpuMode()),
 		//"-debug", "all",
-		// TODO: Add image overriding
-		//"-kernel", "/android/system-image/kernel-ranchu",
-		//"-vendor", "/android/system-image/vendor.img",
-		//"-system", "/android/system-image/system.img",
-		//"-encryption-key", "/android/system-image/encryptionkey.img",
-		//"-ramdisk", "/android/system-image/ramdisk.img",
-		//"-data", "/android/system-image/userdata.img",
-		"-qemu", "-append", "panic=1",
-	)
+	}
+
+	if e.request.Snapshot != "" {
+		args = append(args, "-snapshot", e.request.Snapshot)
+	} else if e.request.KeepUserData {
+		args = append(args, "-no-snapshot-load")
+	} else {
+		args = append(args, "-wipe-data")
+	}
+
+	if e.request.ImageOverrides != nil {
+		imageArgs, err := imageArgs(e.request.ImageOverrides)
+		if err != nil {
+			return err
+		}
+
+		args = append(args, imageArgs...)
+	}
+
+	// QEMU arguments must come last
+	args = append(args, "-qemu", "-append", "panic=1")
+
+	cmd := exec.Command("/android/emulator/emulator", args...)
 	cmd.Env = append(cmd.Env, "ANDROID_AVD_HOME=/android/home")
 	cmd.Env = append(cmd.Env, "ANDROID_SDK_ROOT=/android")
 
@@ -132,9 +241,14 @@ func (e *Emulator) startEmulator() error {
 	}
 
 	e.emuCmd = cmd
+	e.startedAt = time.Now()
+
+	e.startBootTimer()
 
 	go e.processLogs()
 
+	go e.watchKernelLog(e.sysLog)
+
 	go e.watchEmulatorExit()
 
 	go e.connect()
@@ -142,6 +256,47 @@ func (e *Emulator) startEmulator() error {
 	return nil
 }
 
+// Version returns the version of the installed emulator.
+func Version() (string, error) {
+	cfg, err := ini.Load("/android/emulator/source.properties")
+	if err != nil {
+		return "", err
+	}
+
+	if !cfg.Section("").HasKey("Pkg.Revision") {
+		return "", errors.New("Pkg.Revision missing")
+	}
+
+	return cfg.Section("").Key("Pkg.Revision").String(), nil
+}
+
+// createSdCard replaces the SD card image with an empty card of the given size in MBs. An existing card of the
+// same size is kept when user data is kept.
+func createSdCard(size uint32, keep bool) error {
+	if keep && size != 0 {
+		info, err := os.Stat(sdCardPath)
+		if err == nil && info.Size() == int64(size)*1024*1024 {
+			return nil
+		}
+	}
+
+	err := os.Remove(sdCardPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if size == 0 {
+		return nil
+	}
+
+	out, err := exec.Command("/android/emulator/mksdcard", fmt.Sprintf("%dM", size), sdCardPath).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to create sd card: %w: %s", err, out)
+	}
+
+	return nil
+}
+
 func (e *Emulator) processLogs() {
 	outChan := make(chan string)
 	errChan := make(chan string)
@@ -149,58 +304,50 @@ func (e *Emulator) processLogs() {
 	go processChannel(e.outPipeReader, outChan)
 	go processChannel(e.errPipeReader, errChan)
 
+	outParser := newLogParser(protocol.EmulatorLogEntry_STDOUT)
+	errParser := newLogParser(protocol.EmulatorLogEntry_STDERR)
+
+	outExcerpt := &logExcerpt{}
+	errExcerpt := &logExcerpt{}
+
 	lastError := ""
-	inError := false
 
-outer:
-	for {
+	// Read until both streams are closed
+	for outChan != nil || errChan != nil {
 		select {
 		case line, ok := <-outChan:
 			if !ok {
-				break outer
+				outChan = nil
+				continue
 			}
 			log.Println("[OUT]", line)
+
+			entry, _ := outParser.parse(line)
+			e.monitor.OnEmulatorLog(entry)
+
+			outExcerpt.add(line)
+			e.fatal.process(line, outExcerpt)
 		case line, ok := <-errChan:
 			if !ok {
-				break outer
+				errChan = nil
+				continue
 			}
 			log.Println("[ERR]", line)
 
-			if strings.HasPrefix(line, "ERROR   |") {
-				inError = true
-				lastError = strings.TrimPrefix(line, "ERROR   |")
-			} else if strings.HasPrefix(line, "WARNING |") || strings.HasPrefix(line, "INFO    |") {
-				inError = false
-			} else if inError {
-				lastError += "\n" + line
-			}
-		}
-	}
+			entry, started := errParser.parse(line)
+			e.monitor.OnEmulatorLog(entry)
 
-	log.Println("Waiting for end of stdout")
-	for {
-		line, ok := <-outChan
-		if !ok {
-			break
-		}
-		log.Println("[OUT]", line)
-	}
+			errExcerpt.add(line)
+			e.fatal.process(line, errExcerpt)
 
-	log.Println("Waiting for end of stderr")
-	for {
-		line, ok := <-errChan
-		if !ok {
-			break
-		}
-		log.Println("[ERR]", line)
-
-		if strings.HasPrefix(line, "ERROR   |") {
-			inError = true
-			lastError = strings.TrimPrefix(line, "ERROR   |")
-		} else if strings.HasPrefix(line, "WARNING |") || strings.HasPrefix(line, "INFO    |") {
-			inError = false
-		} else if inError {
-			lastError += "\n" + line
+			// Track the last error message to report if the emulator fails
+			if entry.Severity != protocol.EmulatorLogEntry_ERROR {
+				continue
+			} else if started {
+				lastError = entry.Message
+			} else {
+				lastError += "\n" + line
+			}
 		}
 	}
 
@@ -238,9 +385,31 @@ func (e *Emulator) watchEmulatorExit() {
 
 	lastError := <-e.exitErr
 
+	e.bootTimer.Stop()
+	e.cancel()
+
+	e.mu.Lock()
+	failure := e.failure
+	stopRequested := e.stopRequested
+
+	if e.stopTimer != nil {
+		e.stopTimer.Stop()
+	}
+
+	// Release the connection, so a failed emulator does not leak it
+	if e.controller != nil {
+		_ = e.controller.Close()
+	}
+	e.mu.Unlock()
+
 	var finalError error
-	if e.forceExitRequested && status.Signaled() && status.Signal() == syscall.SIGKILL {
-		// Don't treat a requested force kill as an error
+	if failure != nil {
+		finalError = fmt.Errorf("%w, last error: %s", failure, lastError)
+	} else if stopRequested {
+		// Don't treat a requested stop as an error, even if it escalated to a kill
+		if status.Signaled() {
+			log.Println("Emulator stopped by signal", status.Signal())
+		}
 	} else if err != nil {
 		finalError = fmt.Errorf("emulator exited with: %s, last error: %s", err, lastError)
 	}
@@ -248,75 +417,265 @@ func (e *Emulator) watchEmulatorExit() {
 	e.monitor.OnEmulatorExit(finalError)
 }
 
+// connect waits for the emulator to boot, killing it if any step fails.
 func (e *Emulator) connect() {
-	conn, err := controller.Connect(":8554")
-	if err != nil {
-		log.Fatal(err)
+	err := e.waitForStart()
+	if errors.Is(err, errCancelled) {
+		return
+	} else if err != nil {
+		e.fail(fmt.Errorf("emulator failed to start: %w", err))
+		return
+	}
+
+	// The boot timer has already killed the emulator if it fired
+	if !e.bootTimer.Stop() {
+		return
+	}
+
+	log.Println("Emulator started")
+	e.monitor.OnEmulatorStarted()
+
+	go e.watchHealth()
+}
+
+func (e *Emulator) waitForStart() error {
+	ctx, cancel := context.WithDeadline(e.ctx, e.bootDeadline)
+	defer cancel()
+
+	conn, err := controller.Connect(ctx, ":8554")
+	if e.ctx.Err() != nil {
+		if conn != nil {
+			_ = conn.Close()
+		}
+
+		return errCancelled
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return e.bootTimeoutError()
+	} else if err != nil {
+		return fmt.Errorf("failed to connect to emulator grpc: %w", err)
 	}
 
 	e.mu.Lock()
 	e.controller = conn
 	e.mu.Unlock()
 
-	go e.processDisplay()
+	go e.processDisplay(0, e.request.LcdWidth, e.request.LcdHeight)
 
 	log.Println("Waiting for ADB connection")
-	err = e.adb.WaitForEmulator()
-	if err != nil {
-		log.Fatal(err)
+	serial, err := e.adb.WaitForEmulator(ctx)
+	if e.ctx.Err() != nil {
+		return errCancelled
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return e.bootTimeoutError()
+	} else if err != nil {
+		return fmt.Errorf("failed to wait for adb: %w", err)
 	}
 
+	e.mu.Lock()
+	e.adbSerial = serial
+	e.mu.Unlock()
+
+	log.Println("Waiting for boot to complete")
+	e.monitor.OnEmulatorBooting()
+
 	// Root the connection if requested
 	if e.request.RootAdb {
-		emuCon, err := e.adb.OpenEmulator()
+		err = e.rootAdb()
 		if err != nil {
-			log.Fatal(err)
+			return fmt.Errorf("failed to root adb: %w", err)
 		}
+	}
 
-		defer emuCon.Close()
-
-		err = emuCon.SendCommand([]byte("root:"))
+	// Attach secondary displays
+	if len(e.request.SecondaryDisplays) > 0 {
+		err = e.configureDisplays()
 		if err != nil {
-			log.Fatal(err)
+			return fmt.Errorf("failed to attach secondary displays: %w", err)
 		}
+	}
 
-		line, err := emuCon.ReadLine()
-		if err != nil {
-			log.Fatal(err)
-		}
+	if !e.waitForBoot() {
+		return errCancelled
+	}
 
-		if line != nil {
-			log.Println("Root response:", *line)
-		} else {
-			log.Println("Root response: NIL")
-		}
+	uid, err := e.adb.Shell(e.ctx, "id -u")
+	if err != nil {
+		return fmt.Errorf("failed to check adb user: %w", err)
+	}
 
+	e.mu.Lock()
+	e.bootedAt = time.Now()
+	e.adbRoot = strings.TrimSpace(uid) == "0"
+	e.mu.Unlock()
+
+	return nil
+}
+
+// Info returns a description of the emulator. Fields are only populated once known.
+func (e *Emulator) Info() Info {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	info := Info{
+		Image:     e.image,
+		StartedAt: e.startedAt,
+		BootedAt:  e.bootedAt,
+		AdbSerial: e.adbSerial,
+		AdbRoot:   e.adbRoot,
 	}
 
-	log.Println("Emulator started")
-	e.monitor.OnEmulatorStarted()
+	if e.controller != nil {
+		info.Version = e.controller.Version()
+	}
+
+	return info
 }
 
-func (e *Emulator) processDisplay() {
-	display, err := e.controller.StreamDisplay(int(e.request.LcdWidth), int(e.request.LcdHeight))
+func (e *Emulator) rootAdb() error {
+	emuCon, err := e.adb.OpenEmulator()
 	if err != nil {
-		log.Println("Display connection lost")
+		return err
+	}
+
+	defer emuCon.Close()
+
+	err = emuCon.SendCommand([]byte("root:"))
+	if err != nil {
+		return err
+	}
+
+	line, err := emuCon.ReadLine()
+	if err != nil {
+		return err
+	}
+
+	if line != nil {
+		log.Println("Root response:", *line)
+	} else {
+		log.Println("Root response: NIL")
+	}
+
+	return nil
+}
+
+func (e *Emulator) configureDisplays() error {
+	configs := &emuproto.DisplayConfigurations{}
+
+	for i, display := range e.request.SecondaryDisplays {
+		configs.Displays = append(configs.Displays, &emuproto.DisplayConfiguration{
+			Width:   display.Width,
+			Height:  display.Height,
+			Dpi:     display.Density,
+			Flags:   display.Flags,
+			Display: uint32(i + 1),
+		})
+	}
+
+	log.Println("Attaching", len(configs.Displays), "secondary displays")
+	err := e.controller.SetDisplayConfigurations(configs)
+	if err != nil {
+		return err
+	}
+
+	for _, config := range configs.Displays {
+		go e.processDisplay(config.Display, config.Width, config.Height)
+	}
+
+	return nil
+}
+
+func (e *Emulator) processDisplay(id uint32, width uint32, height uint32) {
+	display, err := e.controller.StreamDisplay(id, int(width), int(height))
+	if err != nil {
+		log.Println("Display", id, "connection lost")
 		return
 	}
 
 	for {
 		data, err := display.GetFrame()
 		if err != nil {
-			log.Println("Display connection lost")
+			log.Println("Display", id, "connection lost")
 			return
 		}
 
 		e.monitor.OnEmulatorFrame(Frame{
-			Width:  e.request.LcdWidth,
-			Height: e.request.LcdHeight,
-			Data:   data,
+			Display: id,
+			Width:   width,
+			Height:  height,
+			Data:    data,
+		})
+	}
+}
+
+func (e *Emulator) ListDisplays() ([]*protocol.Display, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.controller == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator not ready")
+	}
+
+	configs, err := e.controller.GetDisplayConfigurations()
+	if err != nil {
+		return nil, err
+	}
+
+	displays := []*protocol.Display{
+		{
+			Id:      0,
+			Width:   e.request.LcdWidth,
+			Height:  e.request.LcdHeight,
+			Density: e.request.LcdDensity,
+		},
+	}
+
+	for _, config := range configs.Displays {
+		// The main display is described by the start request
+		if config.Display == 0 {
+			continue
+		}
+
+		displays = append(displays, &protocol.Display{
+			Id:      config.Display,
+			Width:   config.Width,
+			Height:  config.Height,
+			Density: config.Dpi,
+			Flags:   config.Flags,
 		})
 	}
+
+	return displays, nil
+}
+
+func (e *Emulator) GetScreenshot(request *protocol.ScreenshotRequest) (*protocol.Screenshot, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.controller == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator not ready")
+	}
+
+	var format emuproto.ImageFormat_ImgFormat
+
+	switch request.Format {
+	case protocol.ScreenshotRequest_PNG:
+		format = emuproto.ImageFormat_PNG
+	case protocol.ScreenshotRequest_RGB888:
+		format = emuproto.ImageFormat_RGB888
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown format")
+	}
+
+	img, err := e.controller.GetScreenshot(request.Display, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocol.Screenshot{
+		Width:  img.Format.GetWidth(),
+		Height: img.Format.GetHeight(),
+		Data:   img.Image,
+	}, nil
 }
 
 func (e *Emulator) ProcessInput(request protocol.InputRequest) error {
@@ -341,26 +700,134 @@ func (e *Emulator) ProcessInput(request protocol.InputRequest) error {
 					Expiration: emuproto.Touch_NEVER_EXPIRE,
 				},
 			},
-			Display: 0,
+			Display: int32(event.Touch.Display),
+		})
+	case *protocol.InputRequest_Key:
+		var eventType emuproto.KeyboardEvent_KeyEventType
+
+		switch event.Key.Type {
+		case protocol.KeyEvent_DOWN:
+			eventType = emuproto.KeyboardEvent_keydown
+		case protocol.KeyEvent_UP:
+			eventType = emuproto.KeyboardEvent_keyup
+		case protocol.KeyEvent_PRESS:
+			eventType = emuproto.KeyboardEvent_keypress
+		default:
+			return status.Errorf(codes.InvalidArgument, "unknown key event type")
+		}
+
+		return e.controller.SendKey(&emuproto.KeyboardEvent{
+			EventType: eventType,
+			Key:       event.Key.Key,
 		})
 	default:
 		return status.Errorf(codes.InvalidArgument, "unknown request")
 	}
 }
 
+// Pause freezes the VM. The guest consumes no CPU time until resumed.
+func (e *Emulator) Pause() error {
+	return e.setVmState(emuproto.VmRunState_PAUSED)
+}
+
+// Resume continues a paused VM.
+func (e *Emulator) Resume() error {
+	return e.setVmState(emuproto.VmRunState_RUNNING)
+}
+
+func (e *Emulator) setVmState(state emuproto.VmRunState_RunState) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.controller == nil {
+		return status.Errorf(codes.FailedPrecondition, "emulator not ready")
+	}
+
+	err := e.controller.SetVmState(state)
+	if err != nil {
+		return err
+	}
+
+	e.paused = state == emuproto.VmRunState_PAUSED
+
+	return nil
+}
+
+func (e *Emulator) GetVmState() (protocol.VmState_RunState, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.controller == nil {
+		return protocol.VmState_UNKNOWN, status.Errorf(codes.FailedPrecondition, "emulator not ready")
+	}
+
+	state, err := e.controller.GetVmState()
+	if err != nil {
+		return protocol.VmState_UNKNOWN, err
+	}
+
+	switch state {
+	case emuproto.VmRunState_RUNNING:
+		return protocol.VmState_RUNNING, nil
+	case emuproto.VmRunState_PAUSED:
+		return protocol.VmState_PAUSED, nil
+	case emuproto.VmRunState_RESTORE_VM:
+		return protocol.VmState_RESTORE_VM, nil
+	case emuproto.VmRunState_SAVE_VM:
+		return protocol.VmState_SAVE_VM, nil
+	case emuproto.VmRunState_SHUTDOWN:
+		return protocol.VmState_SHUTDOWN, nil
+	case emuproto.VmRunState_INTERNAL_ERROR:
+		return protocol.VmState_INTERNAL_ERROR, nil
+	default:
+		return protocol.VmState_UNKNOWN, nil
+	}
+}
+
+// Stop requests the emulator exits, cancelling any boot in progress. A graceful stop is escalated to killing the
+// emulator if it has not exited within the request timeout.
 func (e *Emulator) Stop(request *protocol.StopEmulatorRequest) error {
+	// Read without locking the tracker, as Stop is called with the monitor locked
+	booted := e.boot.reached(protocol.AgentState_BOOT_COMPLETED)
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
+	e.stopRequested = true
+	e.bootTimer.Stop()
+	e.cancel()
+
 	// Kill by terminating process group
 	if request.ForceExit {
 		e.forceExitRequested = true
 		return syscall.Kill(-e.emuCmd.Process.Pid, syscall.SIGKILL)
 	}
 
-	if e.controller == nil {
-		return status.Errorf(codes.FailedPrecondition, "emulator not ready")
+	if e.stopTimer == nil {
+		timeout := defaultStopTimeout
+		if request.Timeout != 0 {
+			timeout = time.Duration(request.Timeout) * time.Second
+		}
+
+		e.stopTimer = time.AfterFunc(timeout, func() {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+
+			log.Println("Emulator did not exit within", timeout, "- killing")
+			e.forceExitRequested = true
+			_ = syscall.Kill(-e.emuCmd.Process.Pid, syscall.SIGKILL)
+		})
+	}
+
+	// The guest can only handle a shutdown request once booted, otherwise the emulator is asked to exit directly
+	if e.controller != nil && booted {
+		err := e.controller.RequestExit()
+		if err == nil {
+			return nil
+		}
+
+		log.Println("Shutdown request failed:", err)
 	}
 
-	return e.controller.RequestExit()
+	return syscall.Kill(-e.emuCmd.Process.Pid, syscall.SIGTERM)
 }
@@ -0,0 +1,114 @@
+package emulator
+
+import (
+	"github.com/csnewman/droidmole/agent/protocol"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// fatalExcerptLines is the number of lines included in the excerpt of a fatal event
+	fatalExcerptLines = 20
+
+	// zygoteCrashLimit is the number of zygote exits within zygoteCrashWindow considered a crash loop
+	zygoteCrashLimit  = 3
+	zygoteCrashWindow = time.Minute
+)
+
+var fatalPatterns = []struct {
+	eventType protocol.FatalEvent_Type
+	pattern   *regexp.Regexp
+}{
+	// Checked before kernel panics, as init exiting causes a panic
+	{protocol.FatalEvent_INIT_FAILURE, regexp.MustCompile(`Attempted to kill init|InitFatalReboot|init: Init encountered errors`)},
+	{protocol.FatalEvent_KERNEL_PANIC, regexp.MustCompile(`Kernel panic - not syncing`)},
+	{protocol.FatalEvent_WATCHDOG_RESET, regexp.MustCompile(`soft lockup|hard LOCKUP|softdog: Initiating system reboot`)},
+	{protocol.FatalEvent_ZYGOTE_CRASH_LOOP, regexp.MustCompile(`critical process 'zygote(64)?' exited \d+ times`)},
+}
+
+var zygoteExitPattern = regexp.MustCompile(`Service 'zygote(64)?' \(pid \d+\) (exited|received signal|killed)`)
+
+// fatalDetector detects fatal guest failures in log output. Each event type is only reported once.
+type fatalDetector struct {
+	mu          sync.Mutex
+	monitor     Monitor
+	reported    map[protocol.FatalEvent_Type]bool
+	zygoteExits []time.Time
+}
+
+func newFatalDetector(monitor Monitor) *fatalDetector {
+	return &fatalDetector{
+		monitor:  monitor,
+		reported: make(map[protocol.FatalEvent_Type]bool),
+	}
+}
+
+// logExcerpt retains the most recent lines of a log source.
+type logExcerpt struct {
+	lines []string
+}
+
+func (e *logExcerpt) add(line string) {
+	if len(e.lines) >= fatalExcerptLines {
+		e.lines = e.lines[1:]
+	}
+
+	e.lines = append(e.lines, line)
+}
+
+// process checks a line, which must already have been added to the excerpt of its source.
+func (d *fatalDetector) process(line string, excerpt *logExcerpt) {
+	eventType, ok := d.classify(line)
+	if !ok {
+		return
+	}
+
+	d.mu.Lock()
+	if d.reported[eventType] {
+		d.mu.Unlock()
+		return
+	}
+	d.reported[eventType] = true
+	d.mu.Unlock()
+
+	log.Println("Fatal event detected:", eventType, line)
+
+	d.monitor.OnEmulatorFatalEvent(&protocol.FatalEvent{
+		Type:    eventType,
+		Time:    uint64(time.Now().UnixMilli()),
+		Message: strings.TrimSpace(line),
+		Excerpt: append([]string(nil), excerpt.lines...),
+	})
+}
+
+func (d *fatalDetector) classify(line string) (protocol.FatalEvent_Type, bool) {
+	for _, entry := range fatalPatterns {
+		if entry.pattern.MatchString(line) {
+			return entry.eventType, true
+		}
+	}
+
+	if !zygoteExitPattern.MatchString(line) {
+		return 0, false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+
+	// Only count the exits within the window
+	recent := d.zygoteExits[:0]
+	for _, exit := range d.zygoteExits {
+		if now.Sub(exit) < zygoteCrashWindow {
+			recent = append(recent, exit)
+		}
+	}
+
+	d.zygoteExits = append(recent, now)
+
+	return protocol.FatalEvent_ZYGOTE_CRASH_LOOP, len(d.zygoteExits) >= zygoteCrashLimit
+}
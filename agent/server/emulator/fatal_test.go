@@ -0,0 +1,38 @@
+package emulator
+
+import (
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/matryer/is"
+	"testing"
+)
+
+func TestFatalDetector_Classify(t *testing.T) {
+	is := is.New(t)
+	d := newFatalDetector(nil)
+
+	eventType, ok := d.classify("[    5.1] Kernel panic - not syncing: Attempted to kill init! exitcode=0x00000100")
+	is.True(ok)
+	is.Equal(eventType, protocol.FatalEvent_INIT_FAILURE)
+
+	eventType, ok = d.classify("[    5.1] Kernel panic - not syncing: VFS: Unable to mount root fs")
+	is.True(ok)
+	is.Equal(eventType, protocol.FatalEvent_KERNEL_PANIC)
+
+	eventType, ok = d.classify("[   30.2] watchdog: BUG: soft lockup - CPU#0 stuck for 22s!")
+	is.True(ok)
+	is.Equal(eventType, protocol.FatalEvent_WATCHDOG_RESET)
+
+	_, ok = d.classify("[   12.0] init: starting service 'zygote'...")
+	is.True(!ok)
+
+	// Zygote exits are only fatal once repeated
+	exit := "[   14.0] init: Service 'zygote' (pid 312) exited with status 1"
+	for i := 1; i < zygoteCrashLimit; i++ {
+		_, ok = d.classify(exit)
+		is.True(!ok)
+	}
+
+	eventType, ok = d.classify(exit)
+	is.True(ok)
+	is.Equal(eventType, protocol.FatalEvent_ZYGOTE_CRASH_LOOP)
+}
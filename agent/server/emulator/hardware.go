@@ -0,0 +1,212 @@
+package emulator
+
+import (
+	"fmt"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"strconv"
+	"strings"
+)
+
+const defaultDataPartitionSize = 512
+
+// propertyKind represents the type of value a hardware property accepts.
+type propertyKind int
+
+const (
+	kindBool propertyKind = iota
+	kindInt
+	kindString
+)
+
+// hardwareProperties lists the "hw.*" keys accepted as passthrough properties.
+var hardwareProperties = map[string]propertyKind{
+	"hw.accelerometer":                       kindBool,
+	"hw.arc":                                 kindBool,
+	"hw.audioInput":                          kindBool,
+	"hw.audioOutput":                         kindBool,
+	"hw.battery":                             kindBool,
+	"hw.dPad":                                kindBool,
+	"hw.device.manufacturer":                 kindString,
+	"hw.gltransport":                         kindString,
+	"hw.gps":                                 kindBool,
+	"hw.gsmModem":                            kindBool,
+	"hw.gyroscope":                           kindBool,
+	"hw.keyboard.charmap":                    kindString,
+	"hw.keyboard.lid":                        kindBool,
+	"hw.lcd.backlight":                       kindBool,
+	"hw.lcd.depth":                           kindInt,
+	"hw.lcd.vsync":                           kindInt,
+	"hw.mainKeys":                            kindBool,
+	"hw.rotaryInput":                         kindBool,
+	"hw.screen":                              kindString,
+	"hw.sensor.hinge":                        kindBool,
+	"hw.sensors.gyroscope_uncalibrated":      kindBool,
+	"hw.sensors.humidity":                    kindBool,
+	"hw.sensors.light":                       kindBool,
+	"hw.sensors.magnetic_field":              kindBool,
+	"hw.sensors.magnetic_field_uncalibrated": kindBool,
+	"hw.sensors.orientation":                 kindBool,
+	"hw.sensors.pressure":                    kindBool,
+	"hw.sensors.proximity":                   kindBool,
+	"hw.sensors.temperature":                 kindBool,
+	"hw.trackBall":                           kindBool,
+	"hw.useext4":                             kindBool,
+}
+
+// reservedProperties lists the "hw.*" keys configured by dedicated request fields.
+var reservedProperties = map[string]string{
+	"hw.camera.back":        "camera_back",
+	"hw.camera.front":       "camera_front",
+	"hw.cpu.arch":           "",
+	"hw.cpu.ncore":          "core_count",
+	"hw.gpu.enabled":        "gpu_mode",
+	"hw.gpu.mode":           "gpu_mode",
+	"hw.initialOrientation": "orientation",
+	"hw.keyboard":           "keyboard",
+	"hw.lcd.density":        "lcd_density",
+	"hw.lcd.height":         "lcd_height",
+	"hw.lcd.width":          "lcd_width",
+	"hw.ramSize":            "ram_size",
+	"hw.sdCard":             "sdcard_size",
+}
+
+// ValidateRequest checks a start request for invalid hardware configuration, snapshot, system image and image
+// overrides.
+func ValidateRequest(request *protocol.StartEmulatorRequest) error {
+	if _, ok := protocol.StartEmulatorRequest_GpuMode_name[int32(request.GetGpuMode())]; !ok {
+		return fmt.Errorf("unknown gpu mode %d", request.GetGpuMode())
+	}
+
+	if _, ok := protocol.StartEmulatorRequest_Camera_name[int32(request.CameraBack)]; !ok {
+		return fmt.Errorf("unknown back camera %d", request.CameraBack)
+	}
+
+	if _, ok := protocol.StartEmulatorRequest_Camera_name[int32(request.CameraFront)]; !ok {
+		return fmt.Errorf("unknown front camera %d", request.CameraFront)
+	}
+
+	if request.CameraFront == protocol.StartEmulatorRequest_CAMERA_VIRTUAL_SCENE {
+		return fmt.Errorf("the virtual scene is only supported by the back camera")
+	}
+
+	if _, ok := protocol.StartEmulatorRequest_NetworkProfile_name[int32(request.NetworkProfile)]; !ok {
+		return fmt.Errorf("unknown network profile %d", request.NetworkProfile)
+	}
+
+	if _, ok := protocol.StartEmulatorRequest_Orientation_name[int32(request.Orientation)]; !ok {
+		return fmt.Errorf("unknown orientation %d", request.Orientation)
+	}
+
+	for key, value := range request.HardwareProperties {
+		err := validateProperty(key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	if request.Snapshot != "" {
+		err := ValidateSnapshotName(request.Snapshot)
+		if err != nil {
+			return err
+		}
+
+		_, err = DescribeSnapshot(request.Snapshot)
+		if err != nil {
+			return err
+		}
+	}
+
+	image, err := FindSystemImage(request.SystemImage)
+	if err != nil {
+		return err
+	}
+
+	return validateImages(request.ImageOverrides, image.Abi)
+}
+
+func validateProperty(key string, value string) error {
+	if !strings.HasPrefix(key, "hw.") {
+		return fmt.Errorf("hardware property %s must start with \"hw.\"", key)
+	}
+
+	if field, ok := reservedProperties[key]; ok {
+		if field == "" {
+			return fmt.Errorf("hardware property %s is determined by the system image", key)
+		}
+
+		return fmt.Errorf("hardware property %s must be configured using %s", key, field)
+	}
+
+	kind, ok := hardwareProperties[key]
+	if !ok {
+		return fmt.Errorf("unknown hardware property %s", key)
+	}
+
+	switch kind {
+	case kindBool:
+		if value != "yes" && value != "no" {
+			return fmt.Errorf("hardware property %s must be \"yes\" or \"no\"", key)
+		}
+	case kindInt:
+		_, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("hardware property %s must be an integer", key)
+		}
+	}
+
+	return nil
+}
+
+func gpuMode(mode protocol.StartEmulatorRequest_GpuMode) string {
+	switch mode {
+	case protocol.StartEmulatorRequest_GPU_HOST:
+		return "host"
+	case protocol.StartEmulatorRequest_GPU_GUEST:
+		return "guest"
+	case protocol.StartEmulatorRequest_GPU_ANGLE_INDIRECT:
+		return "angle_indirect"
+	case protocol.StartEmulatorRequest_GPU_AUTO:
+		return "auto"
+	default:
+		return "swiftshader_indirect"
+	}
+}
+
+func cameraMode(camera protocol.StartEmulatorRequest_Camera) string {
+	switch camera {
+	case protocol.StartEmulatorRequest_CAMERA_NONE:
+		return "none"
+	case protocol.StartEmulatorRequest_CAMERA_VIRTUAL_SCENE:
+		return "virtualscene"
+	default:
+		return "emulated"
+	}
+}
+
+// networkProfile returns the emulator speed and latency presets of a network profile.
+func networkProfile(profile protocol.StartEmulatorRequest_NetworkProfile) (string, string) {
+	switch profile {
+	case protocol.StartEmulatorRequest_NETWORK_LTE:
+		return "lte", "none"
+	case protocol.StartEmulatorRequest_NETWORK_HSDPA:
+		return "hsdpa", "umts"
+	case protocol.StartEmulatorRequest_NETWORK_UMTS:
+		return "umts", "umts"
+	case protocol.StartEmulatorRequest_NETWORK_EDGE:
+		return "edge", "edge"
+	case protocol.StartEmulatorRequest_NETWORK_GPRS:
+		return "gprs", "gprs"
+	case protocol.StartEmulatorRequest_NETWORK_GSM:
+		return "gsm", "gprs"
+	default:
+		return "full", "none"
+	}
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+
+	return "no"
+}
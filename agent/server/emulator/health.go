@@ -0,0 +1,134 @@
+package emulator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultBootTimeout         = 300 * time.Second
+	defaultHealthCheckInterval = 5 * time.Second
+
+	// healthCheckFailures is the number of consecutive failed health checks after which the emulator is killed
+	healthCheckFailures = 3
+
+	// healthCheckTimeout is how long the guest is given to respond to a health check over adb
+	healthCheckTimeout = 5 * time.Second
+)
+
+func (e *Emulator) bootTimeout() time.Duration {
+	if e.request.BootTimeout == 0 {
+		return defaultBootTimeout
+	}
+
+	return time.Duration(e.request.BootTimeout) * time.Second
+}
+
+func (e *Emulator) healthCheckInterval() time.Duration {
+	if e.request.HealthCheckInterval == 0 {
+		return defaultHealthCheckInterval
+	}
+
+	return time.Duration(e.request.HealthCheckInterval) * time.Second
+}
+
+// startBootTimer kills the emulator if it has not finished booting before the boot timeout.
+func (e *Emulator) startBootTimer() {
+	timeout := e.bootTimeout()
+
+	e.bootDeadline = time.Now().Add(timeout)
+	e.bootTimer = time.AfterFunc(timeout, func() {
+		e.fail(e.bootTimeoutError())
+	})
+}
+
+func (e *Emulator) bootTimeoutError() error {
+	return fmt.Errorf("emulator failed to boot within %s, reached boot stage %s", e.bootTimeout(), e.boot.current())
+}
+
+// fail kills the emulator process group, reporting the given error as the reason the emulator exited.
+func (e *Emulator) fail(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// A requested stop is escalated separately
+	if e.failure != nil || e.stopRequested {
+		return
+	}
+
+	log.Println("Killing emulator:", err)
+	e.failure = err
+
+	killErr := syscall.Kill(-e.emuCmd.Process.Pid, syscall.SIGKILL)
+	if killErr != nil {
+		log.Println("Failed to kill emulator:", killErr)
+	}
+}
+
+// watchHealth periodically checks the emulator gRPC server and adb remain responsive, killing the emulator once it
+// stops responding.
+func (e *Emulator) watchHealth() {
+	ticker := time.NewTicker(e.healthCheckInterval())
+	defer ticker.Stop()
+
+	failures := 0
+
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := e.checkHealth()
+		if err == nil {
+			failures = 0
+			continue
+		}
+
+		failures++
+		log.Println("Health check failed:", err)
+
+		if failures >= healthCheckFailures {
+			e.fail(fmt.Errorf("emulator stopped responding: %w", err))
+			return
+		}
+	}
+}
+
+func (e *Emulator) checkHealth() error {
+	e.mu.Lock()
+	conn := e.controller
+	paused := e.paused
+	snapshotting := e.snapshotOps > 0
+	e.mu.Unlock()
+
+	// The VM does not respond while a snapshot is saved or loaded, which may take a while
+	if snapshotting {
+		return nil
+	}
+
+	_, err := conn.GetStatus()
+	if err != nil {
+		return fmt.Errorf("grpc status check failed: %w", err)
+	}
+
+	// A paused guest cannot respond to adb
+	if paused {
+		return nil
+	}
+
+	// A hung guest never answers, so a timeout counts as a failed check
+	ctx, cancel := context.WithTimeout(e.ctx, healthCheckTimeout)
+	defer cancel()
+
+	_, err = e.adb.Shell(ctx, "echo")
+	if err != nil {
+		return fmt.Errorf("adb check failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,336 @@
+package emulator
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"debug/elf"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/pierrec/lz4/v4"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ImageDir is the directory uploaded images are stored in.
+const ImageDir = "/android/images"
+
+var (
+	ErrUnknownImageFormat = errors.New("unknown image format")
+	ErrUnknownImageAbi    = errors.New("unable to determine image abi")
+
+	// Image names may not start with a dash, as they are passed as emulator arguments
+	imageNamePattern = regexp.MustCompile(`^[A-Za-z0-9._][A-Za-z0-9._-]*$`)
+)
+
+// ImagePath returns the path of an uploaded image.
+func ImagePath(name string) (string, error) {
+	if !imageNamePattern.MatchString(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid image name %q", name)
+	}
+
+	return filepath.Join(ImageDir, name), nil
+}
+
+// resolveImage returns the path of an image override, which is either an absolute path or the name of an uploaded
+// image.
+func resolveImage(image string) (string, error) {
+	if filepath.IsAbs(image) {
+		return filepath.Clean(image), nil
+	}
+
+	return ImagePath(image)
+}
+
+// imageArgs returns the emulator arguments that apply the image overrides.
+func imageArgs(overrides *protocol.ImageOverrides) ([]string, error) {
+	var args []string
+
+	images := []struct {
+		flag  string
+		image *string
+	}{
+		{"-kernel", overrides.Kernel},
+		{"-system", overrides.System},
+		{"-vendor", overrides.Vendor},
+		{"-ramdisk", overrides.Ramdisk},
+		// The data partition is wiped on each boot, so the image is only used as the initial contents
+		{"-initdata", overrides.Data},
+	}
+
+	for _, entry := range images {
+		if entry.image == nil {
+			continue
+		}
+
+		path, err := resolveImage(*entry.image)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, entry.flag, path)
+	}
+
+	return args, nil
+}
+
+// validateImages checks the image overrides exist and match the ABI of the system image.
+func validateImages(overrides *protocol.ImageOverrides, expected string) error {
+	if overrides == nil {
+		return nil
+	}
+
+	images := []struct {
+		name   string
+		image  *string
+		detect func(r io.Reader) (string, error)
+	}{
+		{"kernel", overrides.Kernel, kernelAbi},
+		{"system", overrides.System, partitionAbi},
+		{"vendor", overrides.Vendor, partitionAbi},
+		{"ramdisk", overrides.Ramdisk, ramdiskAbi},
+		{"data", overrides.Data, nil},
+	}
+
+	for _, entry := range images {
+		if entry.image == nil {
+			continue
+		}
+
+		path, err := resolveImage(*entry.image)
+		if err != nil {
+			return fmt.Errorf("%s image: %w", entry.name, err)
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("%s image: %w", entry.name, err)
+		}
+
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("%s image: %s is not a regular file", entry.name, path)
+		}
+
+		if entry.detect == nil {
+			continue
+		}
+
+		abi, err := detectImageAbi(path, entry.detect)
+		if err != nil {
+			return fmt.Errorf("%s image: %w", entry.name, err)
+		}
+
+		if abi != expected {
+			return fmt.Errorf("%s image: abi %s does not match the system image abi %s", entry.name, abi, expected)
+		}
+	}
+
+	return nil
+}
+
+func detectImageAbi(path string, detect func(r io.Reader) (string, error)) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	return detect(bufio.NewReaderSize(f, 1<<20))
+}
+
+// kernelAbi determines the architecture of an uncompressed x86_64 bzImage or arm64 Image.
+func kernelAbi(r io.Reader) (string, error) {
+	header := make([]byte, 0x240)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return "", ErrUnknownImageFormat
+	}
+
+	if string(header[0x38:0x3C]) == "ARM\x64" {
+		return ARM64V8A, nil
+	}
+
+	// The XLF_KERNEL_64 load flag signifies a 64-bit entry point
+	if string(header[0x202:0x206]) == "HdrS" && header[0x236]&1 != 0 {
+		return X8664, nil
+	}
+
+	return "", ErrUnknownImageFormat
+}
+
+// ramdiskAbi determines the architecture of the first executable within a cpio ramdisk.
+func ramdiskAbi(r io.Reader) (string, error) {
+	br := bufio.NewReader(r)
+
+	magic, err := br.Peek(4)
+	if err != nil {
+		return "", ErrUnknownImageFormat
+	}
+
+	var archive io.Reader
+
+	switch {
+	case bytes.Equal(magic[:2], []byte{0x1F, 0x8B}):
+		archive, err = gzip.NewReader(br)
+		if err != nil {
+			return "", err
+		}
+	case bytes.Equal(magic, []byte{0x02, 0x21, 0x4C, 0x18}), bytes.Equal(magic, []byte{0x04, 0x22, 0x4D, 0x18}):
+		archive = lz4.NewReader(br)
+	case string(magic) == "0707":
+		archive = br
+	default:
+		return "", ErrUnknownImageFormat
+	}
+
+	return cpioAbi(bufio.NewReader(archive))
+}
+
+// cpioAbi walks the entries of newc cpio archives, which may be concatenated, until an executable is found.
+func cpioAbi(r *bufio.Reader) (string, error) {
+	header := make([]byte, 110)
+
+	for {
+		// Skip the padding between archives
+		for {
+			b, err := r.Peek(1)
+			if err == io.EOF {
+				return "", ErrUnknownImageAbi
+			} else if err != nil {
+				return "", err
+			}
+
+			if b[0] != 0 {
+				break
+			}
+
+			_, _ = r.Discard(1)
+		}
+
+		_, err := io.ReadFull(r, header)
+		if err != nil {
+			return "", err
+		}
+
+		if string(header[:6]) != "070701" && string(header[:6]) != "070702" {
+			return "", ErrUnknownImageFormat
+		}
+
+		field := func(i int) (int, error) {
+			value, err := strconv.ParseUint(string(header[6+8*i:14+8*i]), 16, 32)
+			return int(value), err
+		}
+
+		mode, err := field(1)
+		if err != nil {
+			return "", ErrUnknownImageFormat
+		}
+
+		size, err := field(6)
+		if err != nil {
+			return "", ErrUnknownImageFormat
+		}
+
+		nameSize, err := field(11)
+		if err != nil {
+			return "", ErrUnknownImageFormat
+		}
+
+		// The name and data are both padded to a multiple of four bytes
+		_, err = r.Discard(align4(110+nameSize) - 110)
+		if err != nil {
+			return "", err
+		}
+
+		if mode&0170000 == 0100000 && size >= 20 {
+			data, err := r.Peek(20)
+			if err != nil {
+				return "", err
+			}
+
+			abi, ok := elfAbi(data)
+			if ok {
+				return abi, nil
+			}
+		}
+
+		_, err = r.Discard(align4(size))
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// partitionAbi determines the primary ABI of a partition image from the build properties stored within it.
+func partitionAbi(r io.Reader) (string, error) {
+	markers := [][]byte{[]byte("product.cpu.abilist="), []byte("ro.product.cpu.abi=")}
+
+	const overlap = 256
+	buf := make([]byte, 1<<20)
+	filled := 0
+
+	for {
+		n, err := io.ReadFull(r, buf[filled:])
+		filled += n
+
+		for _, marker := range markers {
+			i := bytes.Index(buf[:filled], marker)
+			if i < 0 {
+				continue
+			}
+
+			value := buf[i+len(marker) : filled]
+			end := bytes.IndexAny(value, "\n\x00")
+			if end < 0 && err == nil {
+				// The value continues into the next read
+				continue
+			} else if end >= 0 {
+				value = value[:end]
+			}
+
+			abi, _, _ := strings.Cut(string(value), ",")
+			return strings.TrimSpace(abi), nil
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return "", ErrUnknownImageAbi
+		} else if err != nil {
+			return "", err
+		}
+
+		// Keep the tail to find markers spanning reads
+		filled = copy(buf, buf[filled-overlap:filled])
+	}
+}
+
+// elfAbi determines the ABI of an ELF executable from its header.
+func elfAbi(header []byte) (string, bool) {
+	if !bytes.HasPrefix(header, []byte(elf.ELFMAG)) {
+		return "", false
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if elf.Data(header[elf.EI_DATA]) == elf.ELFDATA2MSB {
+		order = binary.BigEndian
+	}
+
+	switch elf.Machine(order.Uint16(header[18:])) {
+	case elf.EM_X86_64:
+		return X8664, true
+	case elf.EM_AARCH64:
+		return ARM64V8A, true
+	default:
+		return "", false
+	}
+}
+
+func align4(n int) int {
+	return (n + 3) &^ 3
+}
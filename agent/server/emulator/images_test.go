@@ -0,0 +1,94 @@
+package emulator
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+)
+
+func cpioEntry(name string, mode int, data []byte) []byte {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "070701%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X",
+		1, mode, 0, 0, 1, 0, len(data), 0, 0, 0, 0, len(name)+1, 0)
+	b.WriteString(name)
+	b.WriteByte(0)
+	b.Write(make([]byte, align4(b.Len())-b.Len()))
+	b.Write(data)
+	b.Write(make([]byte, align4(b.Len())-b.Len()))
+	return b.Bytes()
+}
+
+func TestRamdiskAbi(t *testing.T) {
+	is := is.New(t)
+
+	// ELF header for an aarch64 executable
+	init := make([]byte, 64)
+	copy(init, "\x7fELF\x02\x01\x01")
+	init[18] = 183
+
+	archive := &bytes.Buffer{}
+	archive.Write(cpioEntry("system", 040755, nil))
+	archive.Write(cpioEntry("fstab", 0100644, []byte("not an executable")))
+	archive.Write(cpioEntry("TRAILER!!!", 0, nil))
+	archive.Write(make([]byte, 256-archive.Len()%256))
+	archive.Write(cpioEntry("init", 0100755, init))
+
+	abi, err := ramdiskAbi(bytes.NewReader(archive.Bytes()))
+	is.NoErr(err)
+	is.Equal(abi, ARM64V8A)
+
+	compressed := &bytes.Buffer{}
+	zw := gzip.NewWriter(compressed)
+	_, _ = zw.Write(archive.Bytes())
+	is.NoErr(zw.Close())
+
+	abi, err = ramdiskAbi(compressed)
+	is.NoErr(err)
+	is.Equal(abi, ARM64V8A)
+
+	_, err = ramdiskAbi(strings.NewReader("garbage"))
+	is.Equal(err, ErrUnknownImageFormat)
+}
+
+func TestImagePath(t *testing.T) {
+	is := is.New(t)
+
+	path, err := ImagePath("kernel-ranchu.img")
+	is.NoErr(err)
+	is.Equal(path, ImageDir+"/kernel-ranchu.img")
+
+	for _, name := range []string{"", ".", "..", "../kernel", "a/b", "a\\b", "-kernel", "kernel img"} {
+		_, err = ImagePath(name)
+		is.True(err != nil) // name must be rejected
+	}
+}
+
+func TestKernelAbi(t *testing.T) {
+	is := is.New(t)
+
+	kernel := make([]byte, 0x1000)
+	copy(kernel[0x202:], "HdrS")
+	kernel[0x236] = 1
+
+	abi, err := kernelAbi(bytes.NewReader(kernel))
+	is.NoErr(err)
+	is.Equal(abi, X8664)
+}
+
+func TestPartitionAbi(t *testing.T) {
+	is := is.New(t)
+
+	image := make([]byte, 3<<20)
+	// Place the properties across a read boundary
+	copy(image[1<<20-10:], "ro.system.product.cpu.abilist=x86_64,x86,arm64-v8a\nro.system.product.cpu.abilist32=x86\n")
+
+	abi, err := partitionAbi(bytes.NewReader(image))
+	is.NoErr(err)
+	is.Equal(abi, X8664)
+
+	_, err = partitionAbi(bytes.NewReader(make([]byte, 1<<20)))
+	is.Equal(err, ErrUnknownImageAbi)
+}
@@ -0,0 +1,62 @@
+package emulator
+
+import (
+	"github.com/csnewman/droidmole/agent/protocol"
+	"strings"
+	"time"
+)
+
+var logSeverities = map[string]protocol.EmulatorLogEntry_Severity{
+	"VERBOSE": protocol.EmulatorLogEntry_VERBOSE,
+	"DEBUG":   protocol.EmulatorLogEntry_DEBUG,
+	"INFO":    protocol.EmulatorLogEntry_INFO,
+	"WARNING": protocol.EmulatorLogEntry_WARNING,
+	"ERROR":   protocol.EmulatorLogEntry_ERROR,
+	"FATAL":   protocol.EmulatorLogEntry_FATAL,
+}
+
+// logParser parses the lines of an emulator output stream. Lines without a severity prefix continue the previous
+// message, so inherit its severity.
+type logParser struct {
+	stream   protocol.EmulatorLogEntry_Stream
+	severity protocol.EmulatorLogEntry_Severity
+}
+
+func newLogParser(stream protocol.EmulatorLogEntry_Stream) *logParser {
+	return &logParser{
+		stream:   stream,
+		severity: protocol.EmulatorLogEntry_INFO,
+	}
+}
+
+// parse returns the log entry for a line, and whether the line started a new message.
+func (p *logParser) parse(line string) (*protocol.EmulatorLogEntry, bool) {
+	severity, message, ok := parseSeverity(line)
+	if ok {
+		p.severity = severity
+	} else {
+		message = line
+	}
+
+	return &protocol.EmulatorLogEntry{
+		Time:     uint64(time.Now().UnixMilli()),
+		Severity: p.severity,
+		Stream:   p.stream,
+		Message:  message,
+	}, ok
+}
+
+// parseSeverity parses the "SEVERITY |" prefix of an emulator log line.
+func parseSeverity(line string) (protocol.EmulatorLogEntry_Severity, string, bool) {
+	prefix, message, found := strings.Cut(line, "|")
+	if !found {
+		return 0, "", false
+	}
+
+	severity, ok := logSeverities[strings.TrimSpace(prefix)]
+	if !ok {
+		return 0, "", false
+	}
+
+	return severity, strings.TrimPrefix(message, " "), true
+}
@@ -0,0 +1,28 @@
+package emulator
+
+import (
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/matryer/is"
+	"testing"
+)
+
+func TestLogParser(t *testing.T) {
+	is := is.New(t)
+	p := newLogParser(protocol.EmulatorLogEntry_STDERR)
+
+	entry, started := p.parse("ERROR   | Unable to connect to adb daemon")
+	is.True(started)
+	is.Equal(entry.Severity, protocol.EmulatorLogEntry_ERROR)
+	is.Equal(entry.Message, "Unable to connect to adb daemon")
+
+	// Continuation lines inherit the severity
+	entry, started = p.parse("  at port 5037")
+	is.True(!started)
+	is.Equal(entry.Severity, protocol.EmulatorLogEntry_ERROR)
+	is.Equal(entry.Message, "  at port 5037")
+
+	entry, started = p.parse("WARNING | Slow boot | retrying")
+	is.True(started)
+	is.Equal(entry.Severity, protocol.EmulatorLogEntry_WARNING)
+	is.Equal(entry.Message, "Slow boot | retrying")
+}
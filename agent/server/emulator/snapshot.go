@@ -0,0 +1,202 @@
+package emulator
+
+import (
+	"errors"
+	"fmt"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/emulator/console"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+)
+
+const (
+	// SnapshotDir is the directory the AVD snapshots are stored in, each within a directory of its name.
+	SnapshotDir = avdDir + "/snapshots"
+
+	consoleAddr     = "127.0.0.1:5556"
+	snapshotTimeout = 5 * time.Minute
+)
+
+var (
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
+	snapshotNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// ValidateSnapshotName checks a snapshot name is safe to use as a directory name and console argument.
+func ValidateSnapshotName(name string) error {
+	if !snapshotNamePattern.MatchString(name) || name == "." || name == ".." {
+		return fmt.Errorf("invalid snapshot name %q", name)
+	}
+
+	return nil
+}
+
+// ListSnapshots returns the snapshots stored for the AVD, ordered by name.
+func ListSnapshots() ([]*protocol.Snapshot, error) {
+	entries, err := os.ReadDir(SnapshotDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*protocol.Snapshot
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		snapshot, err := DescribeSnapshot(entry.Name())
+		if errors.Is(err, ErrSnapshotNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Name < snapshots[j].Name
+	})
+
+	return snapshots, nil
+}
+
+// DescribeSnapshot returns the details of a stored snapshot.
+func DescribeSnapshot(name string) (*protocol.Snapshot, error) {
+	dir := filepath.Join(SnapshotDir, name)
+
+	// The snapshot metadata is written last, so its presence signifies a complete snapshot
+	info, err := os.Stat(filepath.Join(dir, "snapshot.pb"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrSnapshotNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var size uint64
+
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.Type().IsRegular() {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocol.Snapshot{
+		Name:      name,
+		Size:      size,
+		CreatedAt: info.ModTime().Unix(),
+	}, nil
+}
+
+// DeleteSnapshotFiles removes a stored snapshot. Must only be used while the emulator is stopped.
+func DeleteSnapshotFiles(name string) error {
+	err := snapshotExists(name)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(filepath.Join(SnapshotDir, name))
+}
+
+// snapshotExists checks the directory of a snapshot exists, including partially written snapshots.
+func snapshotExists(name string) error {
+	_, err := os.Stat(filepath.Join(SnapshotDir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrSnapshotNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to stat snapshot %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// SaveSnapshot saves the state of the running VM under the given name, replacing any existing snapshot.
+func (e *Emulator) SaveSnapshot(name string) (*protocol.Snapshot, error) {
+	_, err := e.snapshotCommand("avd snapshot save " + name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	return DescribeSnapshot(name)
+}
+
+// LoadSnapshot restores the running VM to the given snapshot.
+func (e *Emulator) LoadSnapshot(name string) error {
+	_, err := DescribeSnapshot(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.snapshotCommand("avd snapshot load " + name)
+	if err != nil {
+		return fmt.Errorf("failed to load snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSnapshot deletes a snapshot while the emulator is running.
+func (e *Emulator) DeleteSnapshot(name string) error {
+	err := snapshotExists(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.consoleCommand("avd snapshot delete " + name)
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// snapshotCommand runs a console command that saves or loads the VM, suspending health checks until it completes.
+func (e *Emulator) snapshotCommand(command string) (string, error) {
+	e.mu.Lock()
+	e.snapshotOps++
+	e.mu.Unlock()
+
+	defer func() {
+		e.mu.Lock()
+		e.snapshotOps--
+		e.mu.Unlock()
+	}()
+
+	return e.consoleCommand(command)
+}
+
+func (e *Emulator) consoleCommand(command string) (string, error) {
+	e.consoleMu.Lock()
+	defer e.consoleMu.Unlock()
+
+	conn, err := console.Connect(consoleAddr)
+	if err != nil {
+		return "", err
+	}
+
+	defer conn.Close()
+
+	return conn.Command(command, snapshotTimeout)
+}
@@ -0,0 +1,194 @@
+package emulator
+
+import (
+	"errors"
+	"fmt"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"gopkg.in/ini.v1"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SystemImageDir is the directory containing the installed system images, each within a directory of its name.
+	SystemImageDir = "/android/system-images"
+
+	// legacySystemImageDir is the location of the single system image of older containers. The image is listed under
+	// its directory name and is booted when no image is named.
+	legacySystemImageDir = "/android/system-image"
+)
+
+const (
+	TagDefault              = "default"
+	TagGoogleApis           = "google_apis"
+	TagGoogleApisPlaystore  = "google_apis_playstore"
+	sourcePropertiesTagName = "SystemImage.TagId"
+)
+
+var ErrSystemImageNotFound = errors.New("system image not found")
+
+// SystemImage represents an installed system image.
+type SystemImage struct {
+	Name        string
+	Dir         string
+	ApiLevel    uint32
+	Abi         string
+	Tag         string
+	Fingerprint string
+}
+
+// ListSystemImages returns the installed system images, ordered by name.
+func ListSystemImages() ([]*SystemImage, error) {
+	var images []*SystemImage
+
+	entries, err := os.ReadDir(SystemImageDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		image, err := loadSystemImage(entry.Name(), filepath.Join(SystemImageDir, entry.Name()))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		images = append(images, image)
+	}
+
+	legacy, err := loadSystemImage(filepath.Base(legacySystemImageDir), legacySystemImageDir)
+	if err == nil && !containsSystemImage(images, legacy.Name) {
+		images = append(images, legacy)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Name < images[j].Name
+	})
+
+	return images, nil
+}
+
+// FindSystemImage returns the installed system image of the given name. When no name is given, the legacy system
+// image is returned if present, otherwise the only installed image.
+func FindSystemImage(name string) (*SystemImage, error) {
+	if name == "" {
+		image, err := loadSystemImage(filepath.Base(legacySystemImageDir), legacySystemImageDir)
+		if !errors.Is(err, os.ErrNotExist) {
+			return image, err
+		}
+
+		images, err := ListSystemImages()
+		if err != nil {
+			return nil, err
+		}
+
+		switch len(images) {
+		case 0:
+			return nil, ErrSystemImageNotFound
+		case 1:
+			return images[0], nil
+		default:
+			return nil, errors.New("multiple system images installed, one must be named")
+		}
+	}
+
+	if name == "." || name == ".." || strings.ContainsAny(name, "/\\") {
+		return nil, fmt.Errorf("invalid system image name %q", name)
+	}
+
+	dir := filepath.Join(SystemImageDir, name)
+	if name == filepath.Base(legacySystemImageDir) {
+		if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+			dir = legacySystemImageDir
+		}
+	}
+
+	image, err := loadSystemImage(name, dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrSystemImageNotFound
+	}
+
+	return image, err
+}
+
+func containsSystemImage(images []*SystemImage, name string) bool {
+	for _, image := range images {
+		if image.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadSystemImage reads the properties of the system image in the given directory.
+func loadSystemImage(name string, dir string) (*SystemImage, error) {
+	buildProp, err := os.ReadFile(filepath.Join(dir, "build.prop"))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := ini.Load(buildProp)
+	if err != nil {
+		return nil, fmt.Errorf("system image %s: %w", name, err)
+	}
+
+	sect := cfg.Section("")
+
+	if !sect.HasKey("ro.product.cpu.abi") {
+		return nil, fmt.Errorf("system image %s: ro.product.cpu.abi missing", name)
+	}
+
+	apiLevel, err := strconv.ParseUint(sect.Key("ro.build.version.sdk").String(), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("system image %s: invalid ro.build.version.sdk", name)
+	}
+
+	return &SystemImage{
+		Name:        name,
+		Dir:         dir,
+		ApiLevel:    uint32(apiLevel),
+		Abi:         sect.Key("ro.product.cpu.abi").String(),
+		Tag:         systemImageTag(dir, sect),
+		Fingerprint: sect.Key("ro.build.fingerprint").String(),
+	}, nil
+}
+
+// systemImageTag determines the variant of a system image. The SDK package properties are used when present,
+// otherwise the variant is inferred from the build properties.
+func systemImageTag(dir string, buildProp *ini.Section) string {
+	source, err := ini.Load(filepath.Join(dir, "source.properties"))
+	if err == nil && source.Section("").HasKey(sourcePropertiesTagName) {
+		return source.Section("").Key(sourcePropertiesTagName).String()
+	}
+
+	// Google API images are built as "sdk_gphone" products, with the Play Store variant using user builds
+	if !strings.Contains(buildProp.Key("ro.product.name").String(), "gphone") {
+		return TagDefault
+	}
+
+	if buildProp.Key("ro.build.type").String() == "user" {
+		return TagGoogleApisPlaystore
+	}
+
+	return TagGoogleApis
+}
+
+func (i *SystemImage) ToProtocol() *protocol.SystemImage {
+	return &protocol.SystemImage{
+		Name:     i.Name,
+		ApiLevel: i.ApiLevel,
+		Abi:      i.Abi,
+		Tag:      i.Tag,
+	}
+}
@@ -0,0 +1,36 @@
+package emulator
+
+import (
+	"github.com/matryer/is"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadSystemImage(t *testing.T) {
+	is := is.New(t)
+
+	dir := t.TempDir()
+	buildProp := "# begin build properties\n" +
+		"ro.build.version.sdk=33\n" +
+		"ro.build.type=user\n" +
+		"ro.product.name=sdk_gphone64_x86_64\n" +
+		"ro.product.cpu.abi=x86_64\n"
+	is.NoErr(os.WriteFile(filepath.Join(dir, "build.prop"), []byte(buildProp), 0644))
+
+	image, err := loadSystemImage("android33", dir)
+	is.NoErr(err)
+	is.Equal(image.ApiLevel, uint32(33))
+	is.Equal(image.Abi, X8664)
+	is.Equal(image.Tag, TagGoogleApisPlaystore)
+
+	// The SDK package properties take precedence
+	is.NoErr(os.WriteFile(filepath.Join(dir, "source.properties"), []byte("SystemImage.TagId=google_apis\n"), 0644))
+
+	image, err = loadSystemImage("android33", dir)
+	is.NoErr(err)
+	is.Equal(image.Tag, TagGoogleApis)
+
+	_, err = loadSystemImage("missing", filepath.Join(dir, "missing"))
+	is.True(os.IsNotExist(err))
+}
@@ -0,0 +1,10 @@
+package encoder
+
+// Encoder compresses raw RGB888 frames of a fixed size.
+type Encoder interface {
+	// Encode compresses a single frame, returning the packets produced.
+	Encode(rgb []byte, keyframe bool) ([][]byte, error)
+
+	// Free releases the resources held by the encoder.
+	Free()
+}
@@ -0,0 +1,68 @@
+package encoder
+
+import (
+	"github.com/csnewman/droidmole/agent/util/vpx"
+	"github.com/csnewman/droidmole/agent/util/x264"
+)
+
+type h264Encoder struct {
+	width  uint32
+	height uint32
+	enc    *x264.Encoder
+	pic    *x264.Picture
+	pts    int64
+}
+
+// NewH264 creates a realtime H.264 encoder for frames of the given size, producing a baseline profile Annex B byte
+// stream.
+func NewH264(width uint32, height uint32) (Encoder, error) {
+	param := x264.NewParam()
+	defer param.Free()
+
+	err := param.DefaultRealtime(int(width), int(height), 60, 1_000)
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := x264.Open(param)
+	if err != nil {
+		return nil, err
+	}
+
+	pic, err := x264.NewPicture(int(width), int(height))
+	if err != nil {
+		enc.Close()
+		return nil, err
+	}
+
+	return &h264Encoder{
+		width:  width,
+		height: height,
+		enc:    enc,
+		pic:    pic,
+	}, nil
+}
+
+func (e *h264Encoder) Encode(rgb []byte, keyframe bool) ([][]byte, error) {
+	// Convert frame to YUV
+	data := vpx.RgbToYuv(rgb, e.width, e.height)
+	e.pic.Read(int(e.width), int(e.height), data)
+
+	pkt, err := e.enc.Encode(e.pic, e.pts, keyframe)
+	if err != nil {
+		return nil, err
+	}
+
+	e.pts++
+
+	if pkt == nil {
+		return nil, nil
+	}
+
+	return [][]byte{pkt}, nil
+}
+
+func (e *h264Encoder) Free() {
+	e.pic.Free()
+	e.enc.Close()
+}
@@ -0,0 +1,15 @@
+package encoder
+
+type rawEncoder struct{}
+
+// NewRaw creates an encoder that passes RGB888 frames through unmodified.
+func NewRaw() Encoder {
+	return &rawEncoder{}
+}
+
+func (e *rawEncoder) Encode(rgb []byte, _ bool) ([][]byte, error) {
+	return [][]byte{rgb}, nil
+}
+
+func (e *rawEncoder) Free() {
+}
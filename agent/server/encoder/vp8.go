@@ -0,0 +1,92 @@
+package encoder
+
+import (
+	"errors"
+	"github.com/csnewman/droidmole/agent/util/vpx"
+)
+
+type vp8Encoder struct {
+	width    uint32
+	height   uint32
+	img      *vpx.Image
+	codecCtx *vpx.CodecCtx
+	pts      uint64
+}
+
+// NewVP8 creates a realtime VP8 encoder for frames of the given size.
+func NewVP8(width uint32, height uint32) (Encoder, error) {
+	vp8 := vpx.VP8Iface()
+
+	encCfg := vpx.NewCodecEncCfg()
+	defer encCfg.Free()
+
+	err := encCfg.Default(vp8)
+	if err != nil {
+		return nil, err
+	}
+
+	encCfg.SetGW(uint(width))
+	encCfg.SetGH(uint(height))
+	encCfg.SetRcTargetBitrate(1_000)
+	encCfg.SetGErrorResilient(1)
+	encCfg.SetGTimebase(1, 60)
+
+	codecCtx := vpx.NewCodecCtx()
+	err = codecCtx.EncInit(vp8, encCfg, 0)
+	if err != nil {
+		codecCtx.Free()
+		return nil, err
+	}
+
+	img := vpx.NullImage().Alloc(vpx.ImageFormatI420, width, height, 0)
+	if img == nil {
+		codecCtx.Destroy()
+		codecCtx.Free()
+		return nil, errors.New("failed to allocate image")
+	}
+
+	return &vp8Encoder{
+		width:    width,
+		height:   height,
+		img:      img,
+		codecCtx: codecCtx,
+	}, nil
+}
+
+func (e *vp8Encoder) Encode(rgb []byte, keyframe bool) ([][]byte, error) {
+	// Convert frame to YUV
+	data := vpx.RgbToYuv(rgb, e.width, e.height)
+	e.img.Read(data)
+
+	flags := vpx.EFlagNone
+	if keyframe {
+		flags = vpx.EFlagForceKF
+	}
+
+	err := e.codecCtx.Encode(e.img, vpx.CodecPts(e.pts), uint64(1), flags, vpx.DLRealtime)
+	if err != nil {
+		return nil, err
+	}
+
+	e.pts++
+
+	// Extract packets
+	var packets [][]byte
+	var iter vpx.CodecIter
+	for {
+		pkt := e.codecCtx.GetFrameBuffer(&iter)
+		if pkt == nil {
+			break
+		}
+
+		packets = append(packets, pkt)
+	}
+
+	return packets, nil
+}
+
+func (e *vp8Encoder) Free() {
+	e.img.Free()
+	e.codecCtx.Destroy()
+	e.codecCtx.Free()
+}
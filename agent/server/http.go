@@ -0,0 +1,258 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/csnewman/droidmole/agent/server/emulator"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	mjpegBoundary   = "droidmoleframe"
+	defaultMjpegFps = 10
+	maxMjpegFps     = 60
+	jpegQuality     = 80
+	snapshotTimeout = 5 * time.Second
+)
+
+var (
+	errNoFrame         = errors.New("no frame available")
+	errDisplayNotFound = errors.New("display not found")
+)
+
+// serveHTTP serves the displays as MJPEG streams and JPEG/PNG snapshots.
+func (s *Server) serveHTTP(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/display.mjpeg", s.handleMjpeg)
+	mux.HandleFunc("/display.jpeg", s.handleSnapshot)
+	mux.HandleFunc("/display.jpg", s.handleSnapshot)
+	mux.HandleFunc("/display.png", s.handleSnapshot)
+
+	server := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+
+	s.mu.Lock()
+	if s.shuttingDown {
+		s.mu.Unlock()
+		return
+	}
+	s.httpServer = server
+	s.mu.Unlock()
+
+	s.log.Info("Serving HTTP display on ", addr)
+
+	err := server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.log.Error("HTTP server failed: ", err)
+	}
+}
+
+// displayQuery represents the common query parameters of the display endpoints.
+type displayQuery struct {
+	display uint32
+	fps     uint32
+	scale   float64
+}
+
+func parseDisplayQuery(r *http.Request) (*displayQuery, error) {
+	q := &displayQuery{
+		fps:   defaultMjpegFps,
+		scale: 1,
+	}
+
+	values := r.URL.Query()
+
+	if v := values.Get("display"); v != "" {
+		display, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid display: %w", err)
+		}
+
+		q.display = uint32(display)
+	}
+
+	if v := values.Get("fps"); v != "" {
+		fps, err := strconv.ParseUint(v, 10, 32)
+		if err != nil || fps == 0 || fps > maxMjpegFps {
+			return nil, fmt.Errorf("fps must be between 1 and %d", maxMjpegFps)
+		}
+
+		q.fps = uint32(fps)
+	}
+
+	if v := values.Get("scale"); v != "" {
+		scale, err := strconv.ParseFloat(v, 64)
+		if err != nil || scale <= 0 || scale > 1 {
+			return nil, errors.New("scale must be greater than 0 and at most 1")
+		}
+
+		q.scale = scale
+	}
+
+	return q, nil
+}
+
+func (s *Server) handleSnapshot(w http.ResponseWriter, r *http.Request) {
+	q, err := parseDisplayQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	frames, ok := s.frameBroadcaster(q.display)
+	if !ok {
+		http.Error(w, errDisplayNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), snapshotTimeout)
+	defer cancel()
+
+	listener := frames.Listener()
+
+	frame, err := listener.WaitContext(ctx)
+	if errors.Is(err, context.DeadlineExceeded) || (err == nil && frame == nil) {
+		err = errNoFrame
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	img := frameToImage(frame, q.scale)
+	buf := &bytes.Buffer{}
+
+	if r.URL.Path == "/display.png" {
+		w.Header().Set("Content-Type", "image/png")
+		err = png.Encode(buf, img)
+	} else {
+		w.Header().Set("Content-Type", "image/jpeg")
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	_, _ = w.Write(buf.Bytes())
+}
+
+func (s *Server) handleMjpeg(w http.ResponseWriter, r *http.Request) {
+	q, err := parseDisplayQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	frames, ok := s.frameBroadcaster(q.display)
+	if !ok {
+		http.Error(w, errDisplayNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	ctx := r.Context()
+	listener := frames.Listener()
+
+	w.Header().Set("Content-Type", "multipart/x-mixed-replace; boundary="+mjpegBoundary)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(time.Second / time.Duration(q.fps))
+	defer ticker.Stop()
+
+	buf := &bytes.Buffer{}
+
+	for {
+		frame, err := listener.WaitContext(ctx)
+		if err != nil {
+			return
+		}
+
+		// Skip periods where the display is off
+		if frame != nil {
+			buf.Reset()
+
+			err = jpeg.Encode(buf, frameToImage(frame, q.scale), &jpeg.Options{Quality: jpegQuality})
+			if err != nil {
+				s.log.Error("Error encoding mjpeg frame: ", err)
+				return
+			}
+
+			_, err = fmt.Fprintf(
+				w,
+				"--%s\r\nContent-Type: image/jpeg\r\nContent-Length: %d\r\n\r\n",
+				mjpegBoundary,
+				buf.Len(),
+			)
+			if err != nil {
+				return
+			}
+
+			_, err = w.Write(append(buf.Bytes(), '\r', '\n'))
+			if err != nil {
+				return
+			}
+
+			flusher.Flush()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// frameToImage converts a RGB888 frame into an image, scaling using the nearest pixel.
+func frameToImage(frame *emulator.Frame, scale float64) *image.RGBA {
+	width := int(float64(frame.Width) * scale)
+	height := int(float64(frame.Height) * scale)
+
+	if width < 1 {
+		width = 1
+	}
+
+	if height < 1 {
+		height = 1
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		srcY := y * int(frame.Height) / height
+
+		for x := 0; x < width; x++ {
+			srcX := x * int(frame.Width) / width
+			src := 3 * (srcY*int(frame.Width) + srcX)
+			dst := img.PixOffset(x, y)
+
+			img.Pix[dst] = frame.Data[src]
+			img.Pix[dst+1] = frame.Data[src+1]
+			img.Pix[dst+2] = frame.Data[src+2]
+			img.Pix[dst+3] = 0xFF
+		}
+	}
+
+	return img
+}
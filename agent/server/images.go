@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/emulator"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"os"
+)
+
+func (s *agentControllerServer) UploadImage(server protocol.AgentController_UploadImageServer) error {
+	initMsg, err := server.Recv()
+	if err != nil {
+		return err
+	}
+
+	startMsg := initMsg.GetStart()
+	if startMsg == nil {
+		return status.Errorf(codes.InvalidArgument, "stream must begin with a start request")
+	}
+
+	path, err := emulator.ImagePath(startMsg.Name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = os.MkdirAll(emulator.ImageDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file, so an incomplete upload never replaces an existing image
+	file, err := os.CreateTemp(emulator.ImageDir, ".upload-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	var size uint64
+
+	for {
+		rmsg, err := server.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		msg, ok := (rmsg.Message).(*protocol.UploadImageRequest_Data)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown request")
+		}
+
+		n, err := file.Write(msg.Data.Data)
+		if err != nil {
+			return err
+		}
+
+		size += uint64(n)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("Stored uploaded image ", startMsg.Name)
+
+	return server.SendAndClose(&protocol.UploadedImage{
+		Name: startMsg.Name,
+		Size: size,
+	})
+}
+
+func (s *agentControllerServer) DeleteImage(_ context.Context, request *protocol.DeleteImageRequest) (*empty.Empty, error) {
+	path, err := emulator.ImagePath(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "image not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"github.com/csnewman/droidmole/agent/protocol"
+)
+
+// emulatorLogBacklog is the number of emulator log entries retained for new streams.
+const emulatorLogBacklog = 1000
+
+func (s *Server) OnEmulatorLog(entry *protocol.EmulatorLogEntry) {
+	s.emulatorLog.Append(entry)
+}
+
+func (s *agentControllerServer) StreamEmulatorLog(
+	request *protocol.StreamEmulatorLogRequest,
+	server protocol.AgentController_StreamEmulatorLogServer,
+) error {
+	subscriber := s.server.emulatorLog.Subscribe(emulatorLogBacklog, request.IncludeBacklog)
+	defer subscriber.Close()
+
+	for {
+		entry, err := subscriber.Next(server.Context())
+		if err != nil {
+			s.log.Debug("stopping emulator log stream", err)
+			return nil
+		}
+
+		if entry.Severity < request.MinSeverity {
+			continue
+		}
+
+		err = server.Send(entry)
+		if err != nil {
+			return err
+		}
+	}
+}
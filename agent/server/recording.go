@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/recording"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+const recordingChunkSize = 64 * 1024
+
+func (s *agentControllerServer) StartRecording(_ context.Context, request *protocol.StartRecordingRequest) (*protocol.Recording, error) {
+	frames, ok := s.server.frameBroadcaster(request.Display)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "display not found")
+	}
+
+	return s.server.recordings.Start(frames.Listener(), request, s.server.activeTouches)
+}
+
+func (s *agentControllerServer) StopRecording(_ context.Context, request *protocol.StopRecordingRequest) (*protocol.Recording, error) {
+	return s.server.recordings.Stop(request.Id)
+}
+
+func (s *agentControllerServer) ListRecordings(_ context.Context, _ *empty.Empty) (*protocol.ListRecordingsResponse, error) {
+	return &protocol.ListRecordingsResponse{
+		Recordings: s.server.recordings.List(),
+	}, nil
+}
+
+func (s *agentControllerServer) DownloadRecording(request *protocol.DownloadRecordingRequest, server protocol.AgentController_DownloadRecordingServer) error {
+	file, err := s.server.recordings.Open(request.Id)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	buffer := make([]byte, recordingChunkSize)
+
+	for {
+		n, err := file.Read(buffer)
+		if n > 0 {
+			sendErr := server.Send(&protocol.DownloadRecordingResponse{
+				Data: buffer[:n],
+				Last: false,
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return server.Send(&protocol.DownloadRecordingResponse{
+		Data: nil,
+		Last: true,
+	})
+}
+
+func (s *agentControllerServer) DeleteRecording(_ context.Context, request *protocol.DeleteRecordingRequest) (*empty.Empty, error) {
+	err := s.server.recordings.Delete(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// trackTouch records the active touch points, allowing them to be drawn onto recordings.
+func (s *Server) trackTouch(event *protocol.TouchEvent) {
+	s.touchMu.Lock()
+	defer s.touchMu.Unlock()
+
+	if event.Pressure == 0 {
+		delete(s.touches, event.Identifier)
+		return
+	}
+
+	s.touches[event.Identifier] = recording.Touch{
+		Display: event.Display,
+		X:       event.X,
+		Y:       event.Y,
+	}
+}
+
+func (s *Server) clearTouches() {
+	s.touchMu.Lock()
+	defer s.touchMu.Unlock()
+
+	s.touches = make(map[uint32]recording.Touch)
+}
+
+func (s *Server) activeTouches() []recording.Touch {
+	s.touchMu.Lock()
+	defer s.touchMu.Unlock()
+
+	touches := make([]recording.Touch, 0, len(s.touches))
+	for _, touch := range s.touches {
+		touches = append(touches, touch)
+	}
+
+	return touches
+}
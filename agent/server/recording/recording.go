@@ -0,0 +1,472 @@
+package recording
+
+import (
+	"errors"
+	"fmt"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/emulator"
+	"github.com/csnewman/droidmole/agent/server/encoder"
+	"github.com/csnewman/droidmole/agent/util/broadcaster"
+	"github.com/csnewman/droidmole/agent/util/mp4"
+	"github.com/csnewman/droidmole/agent/util/webm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// Dir is the directory recordings are stored in.
+	Dir = "/android/recordings"
+
+	defaultFps       = 30
+	keyframeInterval = 2 * time.Second
+)
+
+// Touch represents an active touch point on a display.
+type Touch struct {
+	Display uint32
+	X       uint32
+	Y       uint32
+}
+
+// TouchSource returns the currently active touch points.
+type TouchSource func() []Touch
+
+// Manager tracks the recordings stored on the agent.
+type Manager struct {
+	dir        string
+	mu         sync.Mutex
+	recordings map[uint64]*Recording
+	nextId     uint64
+}
+
+func NewManager(dir string) *Manager {
+	return &Manager{
+		dir:        dir,
+		recordings: make(map[uint64]*Recording),
+	}
+}
+
+// Recording represents a single recording of a display.
+type Recording struct {
+	id       uint64
+	path     string
+	request  *protocol.StartRecordingRequest
+	listener *broadcaster.Listener[*emulator.Frame]
+	touches  TouchSource
+	stopped  atomic.Bool
+	stop     chan struct{}
+	done     chan struct{}
+	mu       sync.Mutex
+	state    protocol.Recording_RecordingState
+	err      error
+	duration time.Duration
+}
+
+// Start begins recording the frames produced by the given listener.
+func (m *Manager) Start(
+	listener *broadcaster.Listener[*emulator.Frame],
+	request *protocol.StartRecordingRequest,
+	touches TouchSource,
+) (*protocol.Recording, error) {
+	var ext string
+
+	switch request.Format {
+	case protocol.StartRecordingRequest_WEBM:
+		ext = "webm"
+	case protocol.StartRecordingRequest_MP4:
+		ext = "mp4"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown format")
+	}
+
+	err := os.MkdirAll(m.dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextId++
+
+	r := &Recording{
+		id:       m.nextId,
+		path:     filepath.Join(m.dir, fmt.Sprintf("%d.%s", m.nextId, ext)),
+		request:  request,
+		listener: listener,
+		touches:  touches,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+		state:    protocol.Recording_RECORDING,
+	}
+
+	m.recordings[r.id] = r
+
+	go r.run()
+
+	return r.describe(), nil
+}
+
+// Stop stops a recording, waiting for the file to be finalised.
+func (m *Manager) Stop(id uint64) (*protocol.Recording, error) {
+	r, err := m.get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.halt()
+
+	return r.describe(), nil
+}
+
+// List describes all recordings.
+func (m *Manager) List() []*protocol.Recording {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var recordings []*protocol.Recording
+	for id := uint64(1); id <= m.nextId; id++ {
+		r, ok := m.recordings[id]
+		if ok {
+			recordings = append(recordings, r.describe())
+		}
+	}
+
+	return recordings
+}
+
+// Open opens the file of a stopped recording for reading.
+func (m *Manager) Open(id uint64) (*os.File, error) {
+	r, err := m.get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	state := r.state
+	r.mu.Unlock()
+
+	if state == protocol.Recording_RECORDING {
+		return nil, status.Errorf(codes.FailedPrecondition, "recording in progress")
+	}
+
+	return os.Open(r.path)
+}
+
+// Delete stops a recording if required and removes its file.
+func (m *Manager) Delete(id uint64) error {
+	m.mu.Lock()
+	r, ok := m.recordings[id]
+	delete(m.recordings, id)
+	m.mu.Unlock()
+
+	if !ok {
+		return status.Errorf(codes.NotFound, "recording not found")
+	}
+
+	r.halt()
+
+	err := os.Remove(r.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// StopAll stops all in progress recordings.
+func (m *Manager) StopAll() {
+	m.mu.Lock()
+	recordings := make([]*Recording, 0, len(m.recordings))
+	for _, r := range m.recordings {
+		recordings = append(recordings, r)
+	}
+	m.mu.Unlock()
+
+	for _, r := range recordings {
+		r.halt()
+	}
+}
+
+func (m *Manager) get(id uint64) (*Recording, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r, ok := m.recordings[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "recording not found")
+	}
+
+	return r, nil
+}
+
+func (r *Recording) halt() {
+	if !r.stopped.Swap(true) {
+		close(r.stop)
+		r.listener.Wake()
+	}
+
+	<-r.done
+}
+
+func (r *Recording) describe() *protocol.Recording {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	desc := &protocol.Recording{
+		Id:       r.id,
+		Display:  r.request.Display,
+		Format:   r.request.Format,
+		State:    r.state,
+		Duration: uint32(r.duration.Milliseconds()),
+	}
+
+	if r.err != nil {
+		msg := r.err.Error()
+		desc.Error = &msg
+	}
+
+	stat, err := os.Stat(r.path)
+	if err == nil {
+		desc.Size = uint64(stat.Size())
+	}
+
+	return desc
+}
+
+func (r *Recording) run() {
+	defer close(r.done)
+
+	err := r.record()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		log.Println("Recording", r.id, "failed:", err)
+		r.state = protocol.Recording_FAILED
+		r.err = err
+	} else {
+		r.state = protocol.Recording_FINISHED
+	}
+}
+
+func (r *Recording) record() error {
+	file, err := os.Create(r.path)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	w := &frameWriter{
+		file:        file,
+		format:      r.request.Format,
+		showTouches: r.request.ShowTouches,
+		display:     r.request.Display,
+		touches:     r.touches,
+	}
+	defer w.free()
+
+	err = r.capture(w)
+
+	// Finalise the file even on failure, so that the frames already recorded remain playable
+	closeErr := w.close()
+	if err == nil {
+		err = closeErr
+	}
+
+	r.mu.Lock()
+	r.duration = w.duration()
+	r.mu.Unlock()
+
+	return err
+}
+
+func (r *Recording) capture(w *frameWriter) error {
+	fps := r.request.MaxFps
+	if fps == 0 {
+		fps = defaultFps
+	}
+
+	ticker := time.NewTicker(time.Second / time.Duration(fps))
+	defer ticker.Stop()
+
+	for {
+		frame, err := r.listener.Wait()
+		if errors.Is(err, broadcaster.Closed) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if r.stopped.Load() {
+			return nil
+		}
+
+		err = w.writeFrame(frame)
+		if err != nil {
+			return err
+		}
+
+		r.mu.Lock()
+		r.duration = w.duration()
+		r.mu.Unlock()
+
+		select {
+		case <-ticker.C:
+		case <-r.stop:
+		}
+	}
+}
+
+// muxer writes encoded frames into a container.
+type muxer interface {
+	WriteFrame(data []byte, keyframe bool, timestamp time.Duration) error
+	Duration() time.Duration
+	Close() error
+}
+
+// resizer is implemented by muxers that must be told of a resolution change.
+type resizer interface {
+	Resize(width uint32, height uint32)
+}
+
+// frameWriter encodes and muxes frames into a file.
+type frameWriter struct {
+	file         *os.File
+	format       protocol.StartRecordingRequest_ContainerFormat
+	showTouches  bool
+	display      uint32
+	touches      TouchSource
+	enc          encoder.Encoder
+	muxer        muxer
+	width        uint32
+	height       uint32
+	start        time.Time
+	lastKeyframe time.Time
+	lastFrame    []byte
+}
+
+func (w *frameWriter) writeFrame(frame *emulator.Frame) error {
+	now := time.Now()
+
+	// Skip periods where the display is off
+	if frame == nil {
+		w.lastFrame = nil
+		return nil
+	}
+
+	var err error
+
+	if w.muxer == nil {
+		w.start = now
+
+		w.muxer, err = w.newMuxer(frame.Width, frame.Height)
+		if err != nil {
+			return err
+		}
+	}
+
+	keyframe := now.Sub(w.lastKeyframe) >= keyframeInterval
+
+	// WebM players adapt to a resolution change on the next keyframe, whereas MP4 files start a new sample description
+	if w.enc == nil || frame.Width != w.width || frame.Height != w.height {
+		w.free()
+
+		if r, ok := w.muxer.(resizer); ok {
+			r.Resize(frame.Width, frame.Height)
+		}
+
+		w.enc, err = w.newEncoder(frame.Width, frame.Height)
+		if err != nil {
+			return err
+		}
+
+		w.width = frame.Width
+		w.height = frame.Height
+		keyframe = true
+	}
+
+	data := frame.Data
+	if w.showTouches {
+		data = drawTouches(data, w.width, w.height, w.display, w.touches())
+	}
+
+	return w.encode(data, keyframe, now)
+}
+
+func (w *frameWriter) newMuxer(width uint32, height uint32) (muxer, error) {
+	if w.format == protocol.StartRecordingRequest_MP4 {
+		return mp4.NewWriter(w.file, width, height)
+	}
+
+	return webm.NewWriter(w.file, webm.CodecVP8, width, height)
+}
+
+func (w *frameWriter) newEncoder(width uint32, height uint32) (encoder.Encoder, error) {
+	if w.format == protocol.StartRecordingRequest_MP4 {
+		return encoder.NewH264(width, height)
+	}
+
+	return encoder.NewVP8(width, height)
+}
+
+func (w *frameWriter) encode(data []byte, keyframe bool, now time.Time) error {
+	packets, err := w.enc.Encode(data, keyframe)
+	if err != nil {
+		return err
+	}
+
+	if keyframe {
+		w.lastKeyframe = now
+	}
+
+	for _, pkt := range packets {
+		err = w.muxer.WriteFrame(pkt, keyframe, now.Sub(w.start))
+		if err != nil {
+			return err
+		}
+	}
+
+	w.lastFrame = data
+
+	return nil
+}
+
+func (w *frameWriter) duration() time.Duration {
+	if w.muxer == nil {
+		return 0
+	}
+
+	return w.muxer.Duration()
+}
+
+func (w *frameWriter) close() error {
+	if w.muxer == nil {
+		return nil
+	}
+
+	// Repeat the last frame so the duration covers the entire recording
+	if w.lastFrame != nil {
+		err := w.encode(w.lastFrame, false, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.muxer.Close()
+}
+
+func (w *frameWriter) free() {
+	if w.enc != nil {
+		w.enc.Free()
+		w.enc = nil
+	}
+}
@@ -0,0 +1,56 @@
+package recording
+
+// drawTouches returns a copy of the RGB888 frame with an indicator drawn at each touch point on the given display.
+func drawTouches(rgb []byte, width uint32, height uint32, display uint32, touches []Touch) []byte {
+	if len(touches) == 0 {
+		return rgb
+	}
+
+	out := make([]byte, len(rgb))
+	copy(out, rgb)
+
+	// Scale the indicator with the display
+	radius := int(width / 40)
+	if height > width {
+		radius = int(height / 40)
+	}
+
+	if radius < 4 {
+		radius = 4
+	}
+
+	for _, touch := range touches {
+		if touch.Display != display {
+			continue
+		}
+
+		cx := int(touch.X)
+		cy := int(touch.Y)
+
+		for y := cy - radius; y <= cy+radius; y++ {
+			if y < 0 || y >= int(height) {
+				continue
+			}
+
+			for x := cx - radius; x <= cx+radius; x++ {
+				if x < 0 || x >= int(width) {
+					continue
+				}
+
+				dx := x - cx
+				dy := y - cy
+				if dx*dx+dy*dy > radius*radius {
+					continue
+				}
+
+				// Blend towards white, leaving the content beneath visible
+				i := (y*int(width) + x) * 3
+				for c := 0; c < 3; c++ {
+					out[i+c] = byte((int(out[i+c]) + 255) / 2)
+				}
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/emulator"
+	"time"
+)
+
+const (
+	defaultRestartBackoff    = 5 * time.Second
+	defaultRestartMaxBackoff = 300 * time.Second
+)
+
+// startEmulator starts the emulator with the given request. Must be called with the lock held.
+func (s *Server) startEmulator(request *protocol.StartEmulatorRequest) error {
+	s.bootStage = protocol.AgentState_NOT_BOOTED
+	s.bootProgress = nil
+	s.fatalEvents = nil
+
+	s.attachDisplays(request)
+
+	emu, err := emulator.Start(s.log, s.adb, s.syslog, request, s)
+
+	if err != nil {
+		s.state = StateError
+		s.emu = nil
+		s.stateError = err
+		s.lastFailure = err
+	} else {
+		s.state = StateStarting
+		s.emu = emu
+		s.stateError = nil
+	}
+
+	s.broadcastState()
+
+	return err
+}
+
+// stopEmulator requests the emulator stops, moving to the stopping state. An error is only returned if the request
+// could not be made, a failure to stop is reported through the state instead. Must be called with the lock held.
+func (s *Server) stopEmulator(request *protocol.StopEmulatorRequest) error {
+	// A paused guest cannot respond to a shutdown request
+	if s.state == StatePaused && !request.ForceExit {
+		err := s.emu.Resume()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := s.emu.Stop(request)
+
+	if err != nil {
+		s.state = StateError
+		s.emu = nil
+		s.stateError = err
+	} else {
+		s.state = StateStopping
+	}
+
+	s.broadcastState()
+
+	return nil
+}
+
+// scheduleRestart schedules a restart of the emulator if the restart policy of the last request allows it. Must be
+// called with the lock held.
+func (s *Server) scheduleRestart(failed bool) {
+	policy := s.lastRequest.GetRestartPolicy()
+
+	switch policy.GetMode() {
+	case protocol.RestartPolicy_NEVER:
+		return
+	case protocol.RestartPolicy_ON_FAILURE:
+		if !failed {
+			return
+		}
+	}
+
+	if failed && policy.MaxRetries != 0 && s.consecutiveFailures > policy.MaxRetries {
+		s.log.Info("Not restarting emulator, exceeded ", policy.MaxRetries, " retries")
+		return
+	}
+
+	delay := restartBackoff(policy, s.consecutiveFailures)
+	s.log.Info("Restarting emulator in ", delay)
+
+	s.startRestartTimer(delay)
+}
+
+// startRestartTimer restarts the emulator after the given delay. Must be called with the lock held.
+func (s *Server) startRestartTimer(delay time.Duration) {
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		s.restart(timer)
+	})
+
+	s.restartTimer = timer
+	s.nextRestart = time.Now().Add(delay)
+}
+
+// restartBackoff returns the delay before a restart, which doubles with each consecutive failure.
+func restartBackoff(policy *protocol.RestartPolicy, failures uint32) time.Duration {
+	backoff := defaultRestartBackoff
+	if policy.Backoff != 0 {
+		backoff = time.Duration(policy.Backoff) * time.Second
+	}
+
+	maxBackoff := defaultRestartMaxBackoff
+	if policy.MaxBackoff != 0 {
+		maxBackoff = time.Duration(policy.MaxBackoff) * time.Second
+	}
+
+	for i := uint32(1); i < failures && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+
+	return backoff
+}
+
+func (s *Server) restart(timer *time.Timer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The restart was cancelled or replaced
+	if s.restartTimer != timer {
+		return
+	}
+
+	s.restartTimer = nil
+
+	if s.shuttingDown {
+		s.log.Info("Skipping emulator restart, agent is shutting down")
+		return
+	}
+
+	if s.state != StateStopped && s.state != StateError {
+		return
+	}
+
+	// Retried once the transfer is likely to have completed, rather than abandoning the restart policy
+	if s.avdBusy {
+		delay := restartBackoff(s.lastRequest.GetRestartPolicy(), s.consecutiveFailures)
+		s.log.Info("Delaying emulator restart by ", delay, ", snapshot transfer in progress")
+		s.startRestartTimer(delay)
+		s.broadcastState()
+		return
+	}
+
+	s.restartCount++
+	s.log.Info("Restarting emulator, restart ", s.restartCount)
+
+	err := s.startEmulator(s.lastRequest)
+	if err != nil {
+		s.log.Info("Emulator restart failed: ", err)
+		s.consecutiveFailures++
+		s.scheduleRestart(true)
+		s.broadcastState()
+	}
+}
+
+// cancelRestart cancels any pending restart. Must be called with the lock held.
+func (s *Server) cancelRestart() {
+	if s.restartTimer != nil {
+		s.restartTimer.Stop()
+		s.restartTimer = nil
+	}
+}
@@ -1,14 +1,23 @@
 package server
 
 import (
+	"errors"
+	"fmt"
 	"github.com/csnewman/droidmole/agent/protocol"
 	"github.com/csnewman/droidmole/agent/server/adb"
 	"github.com/csnewman/droidmole/agent/server/emulator"
+	"github.com/csnewman/droidmole/agent/server/recording"
 	"github.com/csnewman/droidmole/agent/server/syslog"
+	"github.com/csnewman/droidmole/agent/server/vnc"
 	"github.com/csnewman/droidmole/agent/util/broadcaster"
+	"github.com/csnewman/droidmole/agent/util/ringbuffer"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"net"
+	"net/http"
 	"sync"
 	"time"
 )
@@ -20,31 +29,96 @@ const (
 	StateStopped        = 1
 	StateStarting       = 2
 	StateRunning        = 3
+	StatePaused         = 4
+	StateBooting        = 5
+	StateStopping       = 6
 )
 
+// Config represents the optional features of the agent.
+type Config struct {
+	// HTTPAddr specifies the address to serve MJPEG streams and snapshots of the displays on.
+	// Leave empty to disable.
+	HTTPAddr string
+
+	// VNCAddr specifies the address to serve the main display over the RFB protocol on. Leave empty to disable.
+	VNCAddr string
+
+	// VNCPassword specifies the password VNC clients must authenticate with. Leave empty to disable authentication.
+	VNCPassword string
+
+	// ShutdownTimeout specifies how long the emulator is given to stop gracefully when the agent shuts down, before
+	// it is killed.
+	ShutdownTimeout time.Duration
+}
+
 type Server struct {
-	log *zap.SugaredLogger
-	adb adb.Adb
+	log    *zap.SugaredLogger
+	adb    adb.Adb
+	config Config
 
 	state            State
 	stateError       error
+	bootStage        protocol.AgentState_BootStage
+	bootProgress     []*protocol.BootProgress
+	fatalEvents      []*protocol.FatalEvent
 	mu               sync.Mutex
 	emu              *emulator.Emulator
+	avdBusy          bool
 	stateBroadcaster *broadcaster.Broadcaster[*protocol.AgentState]
-	frameBroadcaster *broadcaster.Broadcaster[*emulator.Frame]
 	syslog           *syslog.SysLog
+	emulatorLog      *ringbuffer.Buffer[*protocol.EmulatorLogEntry]
+
+	lastRequest         *protocol.StartEmulatorRequest
+	restartTimer        *time.Timer
+	nextRestart         time.Time
+	restartCount        uint32
+	consecutiveFailures uint32
+	lastFailure         error
+
+	lastState     *protocol.AgentState
+	sequence      uint64
+	previousState *protocol.AgentState_EmulatorState
+
+	recordings *recording.Manager
+	touchMu    sync.Mutex
+	touches    map[uint32]recording.Touch
+
+	displayMu           sync.Mutex
+	frameBroadcasters   map[uint32]*broadcaster.Broadcaster[*emulator.Frame]
+	displayStreams      map[uint64]*displayProcessor
+	nextDisplayStreamId uint64
+
+	shuttingDown  bool
+	grpcServer    *grpc.Server
+	httpServer    *http.Server
+	vncListener   net.Listener
+	heartbeatDone chan struct{}
+	stopped       chan struct{}
 }
 
-func New(log *zap.SugaredLogger, adb adb.Adb) *Server {
-	return &Server{
-		log:              log,
-		adb:              adb,
-		state:            StateStopped,
-		stateBroadcaster: broadcaster.New[*protocol.AgentState](),
-		frameBroadcaster: broadcaster.New[*emulator.Frame](),
+func New(log *zap.SugaredLogger, adb adb.Adb, config Config) *Server {
+	s := &Server{
+		log:               log,
+		adb:               adb,
+		config:            config,
+		state:             StateStopped,
+		stateBroadcaster:  broadcaster.New[*protocol.AgentState](),
+		emulatorLog:       ringbuffer.New[*protocol.EmulatorLogEntry](emulatorLogBacklog),
+		frameBroadcasters: make(map[uint32]*broadcaster.Broadcaster[*emulator.Frame]),
+		displayStreams:    make(map[uint64]*displayProcessor),
+		recordings:        recording.NewManager(recording.Dir),
+		touches:           make(map[uint32]recording.Touch),
+		heartbeatDone:     make(chan struct{}),
+		stopped:           make(chan struct{}),
 	}
+
+	// The main display is always attached
+	s.frameBroadcasters[0] = broadcaster.New[*emulator.Frame]()
+
+	return s
 }
 
+// Start starts the agent, serving requests until the agent has been shut down.
 func (s *Server) Start() {
 	s.log.Info("Starting agent server")
 
@@ -67,6 +141,14 @@ func (s *Server) Start() {
 		s.log.Fatal("failed to listen", err)
 	}
 
+	if s.config.HTTPAddr != "" {
+		go s.serveHTTP(s.config.HTTPAddr)
+	}
+
+	if s.config.VNCAddr != "" {
+		go s.serveVNC(s.config.VNCAddr)
+	}
+
 	acs := &agentControllerServer{
 		log:    s.log,
 		server: s,
@@ -75,18 +157,33 @@ func (s *Server) Start() {
 	grpcServer := grpc.NewServer()
 	protocol.RegisterAgentControllerServer(grpcServer, acs)
 
+	s.mu.Lock()
+	if s.shuttingDown {
+		s.mu.Unlock()
+		<-s.stopped
+		return
+	}
+	s.grpcServer = grpcServer
+	s.mu.Unlock()
+
 	s.log.Info("Servicing requests")
-	s.log.Fatal(grpcServer.Serve(lis))
+
+	err = grpcServer.Serve(lis)
+	if err != nil {
+		s.log.Fatal(err)
+	}
+
+	// Serving stops once shutdown begins, so wait for the shutdown to complete
+	<-s.stopped
 }
 
 func (s *Server) startHeartbeat() {
 	ticker := time.NewTicker(1 * time.Second)
-	// TODO: Implement
-	done := make(chan bool)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case <-done:
+		case <-s.heartbeatDone:
 			return
 		case <-ticker.C:
 			s.heartbeat()
@@ -98,13 +195,32 @@ func (s *Server) heartbeat() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// TODO: Check state
+	// The emulator health is checked by the emulator itself, which reports failures through OnEmulatorExit
 
 	s.broadcastState()
 }
 
 func (s *Server) broadcastState() {
-	newState := &protocol.AgentState{}
+	newState := &protocol.AgentState{
+		BootStage:    s.bootStage,
+		BootProgress: s.bootProgress,
+		RestartCount: s.restartCount,
+		FatalEvents:  s.fatalEvents,
+	}
+
+	if s.lastFailure != nil {
+		msg := s.lastFailure.Error()
+		newState.LastFailure = &msg
+	}
+
+	if s.restartTimer != nil {
+		next := uint64(s.nextRestart.UnixMilli())
+		newState.NextRestart = &next
+	}
+
+	if s.emu != nil {
+		s.describeEmulator(newState)
+	}
 
 	switch s.state {
 	case StateError:
@@ -117,25 +233,146 @@ func (s *Server) broadcastState() {
 		newState.EmulatorState = protocol.AgentState_STARTING
 	case StateRunning:
 		newState.EmulatorState = protocol.AgentState_RUNNING
+	case StatePaused:
+		newState.EmulatorState = protocol.AgentState_PAUSED
+	case StateBooting:
+		newState.EmulatorState = protocol.AgentState_BOOTING
+	case StateStopping:
+		newState.EmulatorState = protocol.AgentState_STOPPING
 	}
 
+	s.sequenceState(newState)
+
 	s.stateBroadcaster.Broadcast(newState)
 }
 
+// sequenceState assigns the sequence number and previous emulator state of a state, advancing the sequence if the
+// state differs from the last broadcast state.
+func (s *Server) sequenceState(state *protocol.AgentState) {
+	// The uptime changes on every heartbeat, so is excluded from the comparison
+	compared := proto.Clone(state).(*protocol.AgentState)
+	compared.Uptime = 0
+
+	if s.lastState == nil || !proto.Equal(compared, s.lastState) {
+		s.sequence++
+
+		if s.lastState != nil && s.lastState.EmulatorState != state.EmulatorState {
+			previous := s.lastState.EmulatorState
+			s.previousState = &previous
+		}
+
+		s.lastState = compared
+	}
+
+	state.Sequence = s.sequence
+	state.PreviousState = s.previousState
+}
+
+// describeEmulator adds the details of the current emulator to a state.
+func (s *Server) describeEmulator(state *protocol.AgentState) {
+	info := s.emu.Info()
+
+	state.Config = s.lastRequest
+
+	if info.Version != "" {
+		state.EmulatorVersion = &info.Version
+	}
+
+	if info.Image != nil {
+		state.SystemImage = info.Image.ToProtocol()
+	}
+
+	if !info.StartedAt.IsZero() {
+		startedAt := uint64(info.StartedAt.UnixMilli())
+		state.StartedAt = &startedAt
+	}
+
+	if !info.BootedAt.IsZero() {
+		bootedAt := uint64(info.BootedAt.UnixMilli())
+		state.BootedAt = &bootedAt
+	}
+
+	if info.AdbSerial != "" {
+		state.AdbSerial = &info.AdbSerial
+	}
+
+	state.AdbRoot = info.AdbRoot
+
+	switch s.state {
+	case StateStarting, StateBooting, StateRunning, StatePaused, StateStopping:
+		state.Uptime = uint64(time.Since(info.StartedAt).Milliseconds())
+	}
+}
+
 func (s *Server) OnEmulatorExit(err error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	s.clearTouches()
+
+	stopRequested := s.state == StateStopping
+
+	// Explain the failure using the first fatal event, as the exit error rarely describes the cause
+	if err != nil && len(s.fatalEvents) > 0 {
+		event := s.fatalEvents[0]
+		err = fmt.Errorf("%s: %s: %w", event.Type, event.Message, err)
+	}
+
 	if err != nil {
 		s.log.Info("Emulator exited with error:", err)
 		s.state = StateError
 		s.stateError = err
+		s.lastFailure = err
+		s.consecutiveFailures++
 	} else {
 		s.log.Info("Emulator cleanly exited")
 		s.state = StateStopped
 		s.stateError = nil
 	}
 
+	if !stopRequested && !s.shuttingDown {
+		s.scheduleRestart(err != nil)
+	}
+
+	s.broadcastState()
+}
+
+func (s *Server) OnEmulatorBooting() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state == StateStopping {
+		return
+	}
+
+	s.state = StateBooting
+
+	s.broadcastState()
+}
+
+func (s *Server) OnEmulatorBootStage(stage protocol.AgentState_BootStage, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Stages may be reported out of order by concurrent boot checks
+	if stage > s.bootStage {
+		s.bootStage = stage
+	}
+
+	s.bootProgress = append(s.bootProgress, &protocol.BootProgress{
+		Stage: stage,
+		Time:  uint64(at.UnixMilli()),
+	})
+
+	s.broadcastState()
+}
+
+func (s *Server) OnEmulatorFatalEvent(event *protocol.FatalEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fatalEvents = append(s.fatalEvents, event)
+
 	s.broadcastState()
 }
 
@@ -143,11 +380,93 @@ func (s *Server) OnEmulatorStarted() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.state == StateStopping {
+		return
+	}
+
 	s.state = StateRunning
+	s.consecutiveFailures = 0
 
 	s.broadcastState()
 }
 
+func (s *Server) serveVNC(addr string) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		s.log.Error("failed to listen for vnc: ", err)
+		return
+	}
+
+	s.mu.Lock()
+	if s.shuttingDown {
+		s.mu.Unlock()
+		_ = lis.Close()
+		return
+	}
+	s.vncListener = lis
+	s.mu.Unlock()
+
+	s.log.Info("Serving VNC on ", addr)
+
+	frames, _ := s.frameBroadcaster(0)
+	server := vnc.New(s.log, s.config.VNCPassword, frames, s.sendInput)
+
+	err = server.Serve(lis)
+	if err != nil && !errors.Is(err, net.ErrClosed) {
+		s.log.Error("VNC server failed: ", err)
+	}
+}
+
+// sendInput forwards an input event to the emulator, tracking touches for recordings.
+func (s *Server) sendInput(request *protocol.InputRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state != StateRunning && s.state != StateStarting && s.state != StateBooting {
+		return status.Errorf(codes.FailedPrecondition, "emulator not running")
+	}
+
+	err := s.emu.ProcessInput(*request)
+	if err != nil {
+		return err
+	}
+
+	if touch := request.GetTouch(); touch != nil {
+		s.trackTouch(touch)
+	}
+
+	return nil
+}
+
 func (s *Server) OnEmulatorFrame(frame emulator.Frame) {
-	s.frameBroadcaster.Broadcast(&frame)
+	b, ok := s.frameBroadcaster(frame.Display)
+	if ok {
+		b.Broadcast(&frame)
+	}
+}
+
+// frameBroadcaster returns the broadcaster for the given display, or false if the display is not attached.
+func (s *Server) frameBroadcaster(display uint32) (*broadcaster.Broadcaster[*emulator.Frame], bool) {
+	s.displayMu.Lock()
+	defer s.displayMu.Unlock()
+
+	b, ok := s.frameBroadcasters[display]
+
+	return b, ok
+}
+
+// attachDisplays creates the broadcasters of the secondary displays of a request. The broadcasters are retained
+// between emulator runs, so streams continue across restarts.
+func (s *Server) attachDisplays(request *protocol.StartEmulatorRequest) {
+	s.displayMu.Lock()
+	defer s.displayMu.Unlock()
+
+	for i := range request.SecondaryDisplays {
+		display := uint32(i + 1)
+
+		_, ok := s.frameBroadcasters[display]
+		if !ok {
+			s.frameBroadcasters[display] = broadcaster.New[*emulator.Frame]()
+		}
+	}
 }
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/emulator/controller"
+	"github.com/csnewman/droidmole/agent/util/broadcaster"
+	"math"
+	"time"
+)
+
+const (
+	defaultShutdownTimeout = 30 * time.Second
+
+	// shutdownGrace is how long is allowed for the emulator to exit after being killed, and for the servers to finish
+	// in-flight requests
+	shutdownGrace = 5 * time.Second
+)
+
+// Shutdown stops the agent. New requests are rejected, the emulator is stopped and the open streams are ended with
+// the final state, before the resources held by the agent are released.
+func (s *Server) Shutdown() {
+	s.mu.Lock()
+
+	if s.shuttingDown {
+		s.mu.Unlock()
+		<-s.stopped
+		return
+	}
+
+	s.log.Info("Shutting down agent")
+
+	s.shuttingDown = true
+	s.cancelRestart()
+
+	// In-flight requests are allowed to complete, as the streams are ended below
+	grpcServer := s.grpcServer
+	grpcStopped := make(chan struct{})
+
+	if grpcServer != nil {
+		go func() {
+			grpcServer.GracefulStop()
+			close(grpcStopped)
+		}()
+	} else {
+		close(grpcStopped)
+	}
+
+	// Created while locked, so the exit of the emulator can not be missed
+	listener := s.stateBroadcaster.Listener()
+	stopping := s.stopEmulatorForShutdown()
+
+	s.mu.Unlock()
+
+	if stopping {
+		s.waitForEmulatorExit(listener)
+	}
+
+	close(s.heartbeatDone)
+
+	// End the state streams with the final state
+	s.mu.Lock()
+	s.broadcastState()
+	s.mu.Unlock()
+
+	s.stateBroadcaster.Close()
+
+	s.recordings.StopAll()
+	s.closeFrameBroadcasters()
+	s.emulatorLog.Close()
+
+	if s.syslog != nil {
+		s.syslog.Close()
+	}
+
+	s.stopDisplayServers()
+
+	select {
+	case <-grpcStopped:
+	case <-time.After(shutdownGrace):
+		s.log.Info("Requests did not complete within ", shutdownGrace, " - closing connections")
+		grpcServer.Stop()
+	}
+
+	err := s.adb.KillServer()
+	if err != nil {
+		s.log.Error("Failed to stop adb server: ", err)
+	}
+
+	err = controller.RemoveSharedMemory()
+	if err != nil {
+		s.log.Error("Failed to remove shared memory: ", err)
+	}
+
+	s.log.Info("Agent stopped")
+
+	close(s.stopped)
+}
+
+func (s *Server) shutdownTimeout() time.Duration {
+	if s.config.ShutdownTimeout == 0 {
+		return defaultShutdownTimeout
+	}
+
+	return s.config.ShutdownTimeout
+}
+
+// stopEmulatorForShutdown gracefully stops the emulator if it is running. Returns whether the emulator is stopping.
+// Must be called with the lock held.
+func (s *Server) stopEmulatorForShutdown() bool {
+	switch s.state {
+	case StateStarting, StateBooting, StateRunning, StatePaused:
+	case StateStopping:
+		return true
+	default:
+		return false
+	}
+
+	s.log.Info("Stopping emulator")
+
+	// The stop timeout is in whole seconds, where zero selects the default
+	err := s.stopEmulator(&protocol.StopEmulatorRequest{
+		Timeout: uint32(math.Ceil(s.shutdownTimeout().Seconds())),
+	})
+
+	// The guest could not be resumed to handle a graceful stop
+	if err != nil {
+		s.log.Error("Failed to stop emulator gracefully, killing: ", err)
+		_ = s.stopEmulator(&protocol.StopEmulatorRequest{
+			ForceExit: true,
+		})
+	}
+
+	if s.state == StateError {
+		s.log.Error("Failed to stop emulator: ", s.stateError)
+	}
+
+	return s.state == StateStopping
+}
+
+// waitForEmulatorExit waits for the emulator to exit. The emulator is killed once the shutdown timeout has elapsed,
+// so waiting is abandoned if it has still not exited shortly after.
+func (s *Server) waitForEmulatorExit(listener *broadcaster.Listener[*protocol.AgentState]) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout()+shutdownGrace)
+	defer cancel()
+
+	for {
+		state, err := listener.WaitContext(ctx)
+		if err != nil {
+			s.log.Error("Emulator did not exit: ", err)
+			return
+		}
+
+		if state.EmulatorState == protocol.AgentState_OFF || state.EmulatorState == protocol.AgentState_ERROR {
+			return
+		}
+	}
+}
+
+// closeFrameBroadcasters closes the frame broadcasters, ending the display streams.
+func (s *Server) closeFrameBroadcasters() {
+	s.displayMu.Lock()
+	defer s.displayMu.Unlock()
+
+	for _, b := range s.frameBroadcasters {
+		b.Close()
+	}
+}
+
+// stopDisplayServers stops the optional HTTP and VNC servers.
+func (s *Server) stopDisplayServers() {
+	s.mu.Lock()
+	httpServer := s.httpServer
+	vncListener := s.vncListener
+	s.mu.Unlock()
+
+	if httpServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownGrace)
+		defer cancel()
+
+		err := httpServer.Shutdown(ctx)
+		if err != nil {
+			s.log.Error("Failed to stop HTTP server: ", err)
+		}
+	}
+
+	if vncListener != nil {
+		_ = vncListener.Close()
+	}
+}
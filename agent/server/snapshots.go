@@ -0,0 +1,230 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/emulator"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const snapshotChunkSize = 64 * 1024
+
+// runningEmulator returns the emulator if it has reached the running state.
+func (s *Server) runningEmulator() (*emulator.Emulator, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state != StateRunning {
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator not running")
+	}
+
+	return s.emu, nil
+}
+
+// reserveAvd prevents the emulator from starting while the AVD data is modified. The returned function releases the
+// reservation.
+func (s *Server) reserveAvd() (func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state != StateStopped && s.state != StateError {
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator must be stopped")
+	}
+
+	if s.avdBusy {
+		return nil, status.Errorf(codes.FailedPrecondition, "snapshot transfer in progress")
+	}
+
+	s.avdBusy = true
+
+	return func() {
+		s.mu.Lock()
+		s.avdBusy = false
+		s.mu.Unlock()
+	}, nil
+}
+
+func snapshotError(err error) error {
+	switch {
+	case errors.Is(err, emulator.ErrSnapshotNotFound):
+		return status.Errorf(codes.NotFound, "snapshot not found")
+	case errors.Is(err, emulator.ErrIncompatibleSnapshot):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, emulator.ErrCorruptSnapshot):
+		return status.Errorf(codes.DataLoss, "%v", err)
+	default:
+		return err
+	}
+}
+
+func (s *agentControllerServer) SaveSnapshot(_ context.Context, request *protocol.SaveSnapshotRequest) (*protocol.Snapshot, error) {
+	err := emulator.ValidateSnapshotName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	emu, err := s.server.runningEmulator()
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Saving snapshot ", request.Name)
+
+	return emu.SaveSnapshot(request.Name)
+}
+
+func (s *agentControllerServer) LoadSnapshot(_ context.Context, request *protocol.LoadSnapshotRequest) (*empty.Empty, error) {
+	err := emulator.ValidateSnapshotName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	emu, err := s.server.runningEmulator()
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Loading snapshot ", request.Name)
+
+	err = emu.LoadSnapshot(request.Name)
+	if err != nil {
+		return nil, snapshotError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *agentControllerServer) ListSnapshots(_ context.Context, _ *empty.Empty) (*protocol.ListSnapshotsResponse, error) {
+	snapshots, err := emulator.ListSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocol.ListSnapshotsResponse{
+		Snapshots: snapshots,
+	}, nil
+}
+
+func (s *agentControllerServer) DeleteSnapshot(_ context.Context, request *protocol.DeleteSnapshotRequest) (*empty.Empty, error) {
+	err := emulator.ValidateSnapshotName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// The emulator owns the snapshots while running, so deletion goes through it
+	s.server.mu.Lock()
+	state := s.server.state
+	emu := s.server.emu
+	s.server.mu.Unlock()
+
+	switch state {
+	case StateRunning:
+		err = emu.DeleteSnapshot(request.Name)
+	case StateStarting, StateBooting:
+		return nil, status.Errorf(codes.FailedPrecondition, "emulator is starting")
+	default:
+		var release func()
+
+		release, err = s.server.reserveAvd()
+		if err != nil {
+			return nil, err
+		}
+
+		defer release()
+
+		err = emulator.DeleteSnapshotFiles(request.Name)
+	}
+
+	if err != nil {
+		return nil, snapshotError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// exportWriter sends the data written to it as export responses.
+type exportWriter struct {
+	server protocol.AgentController_ExportSnapshotServer
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	err := w.server.Send(&protocol.ExportSnapshotResponse{
+		Data: p,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (s *agentControllerServer) ExportSnapshot(request *protocol.ExportSnapshotRequest, server protocol.AgentController_ExportSnapshotServer) error {
+	err := emulator.ValidateSnapshotName(request.Name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	release, err := s.server.reserveAvd()
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	s.log.Info("Exporting snapshot ", request.Name)
+
+	w := bufio.NewWriterSize(&exportWriter{server: server}, snapshotChunkSize)
+
+	err = emulator.ExportSnapshot(request.Name, w)
+	if err != nil {
+		return snapshotError(err)
+	}
+
+	return w.Flush()
+}
+
+// importReader reads the data of import requests.
+type importReader struct {
+	server protocol.AgentController_ImportSnapshotServer
+	buffer []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.buffer) == 0 {
+		request, err := r.server.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.buffer = request.Data
+	}
+
+	n := copy(p, r.buffer)
+	r.buffer = r.buffer[n:]
+
+	return n, nil
+}
+
+func (s *agentControllerServer) ImportSnapshot(server protocol.AgentController_ImportSnapshotServer) error {
+	release, err := s.server.reserveAvd()
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	s.log.Info("Importing snapshot")
+
+	snapshot, err := emulator.ImportSnapshot(&importReader{server: server})
+	if err != nil {
+		return snapshotError(err)
+	}
+
+	s.log.Info("Imported snapshot ", snapshot.Name)
+
+	return server.SendAndClose(snapshot)
+}
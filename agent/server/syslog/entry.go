@@ -0,0 +1,67 @@
+package syslog
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Level represents a kernel log level.
+type Level int
+
+const (
+	LevelEmergency Level = iota
+	LevelAlert
+	LevelCritical
+	LevelError
+	LevelWarning
+	LevelNotice
+	LevelInfo
+	LevelDebug
+)
+
+// Entry represents a single line of the syslog.
+type Entry struct {
+	// Line is the raw line.
+	Line string
+
+	// Time is the time the line was received.
+	Time time.Time
+
+	// KernelTime is the time since the kernel booted, if the line has a "[ seconds]" prefix.
+	KernelTime *time.Duration
+
+	// Level is the log level, if the line has a "<level>" prefix.
+	Level *Level
+
+	// Message is the line without the level and time prefixes.
+	Message string
+}
+
+var linePattern = regexp.MustCompile(`^(?:<([0-7])>)?(?:\[\s*(\d+\.\d+)]\s?)?`)
+
+// ParseLine parses the optional level and kernel time prefixes of a line.
+func ParseLine(line string) *Entry {
+	entry := &Entry{
+		Line: line,
+		Time: time.Now(),
+	}
+
+	match := linePattern.FindStringSubmatchIndex(line)
+	entry.Message = line[match[1]:]
+
+	if match[2] >= 0 {
+		level := Level(line[match[2]] - '0')
+		entry.Level = &level
+	}
+
+	if match[4] >= 0 {
+		seconds, err := strconv.ParseFloat(line[match[4]:match[5]], 64)
+		if err == nil {
+			kernelTime := time.Duration(seconds * float64(time.Second))
+			entry.KernelTime = &kernelTime
+		}
+	}
+
+	return entry
+}
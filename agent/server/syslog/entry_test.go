@@ -0,0 +1,26 @@
+package syslog
+
+import (
+	"github.com/matryer/is"
+	"testing"
+	"time"
+)
+
+func TestParseLine(t *testing.T) {
+	is := is.New(t)
+
+	entry := ParseLine("<6>[    1.500000] init: starting service 'zygote'...")
+	is.Equal(*entry.Level, LevelInfo)
+	is.Equal(*entry.KernelTime, 1500*time.Millisecond)
+	is.Equal(entry.Message, "init: starting service 'zygote'...")
+
+	entry = ParseLine("[   12.000001] Run /init as init process")
+	is.True(entry.Level == nil)
+	is.Equal(entry.KernelTime.Seconds(), 12.000001)
+	is.Equal(entry.Message, "Run /init as init process")
+
+	entry = ParseLine("console: plain output")
+	is.True(entry.Level == nil)
+	is.True(entry.KernelTime == nil)
+	is.Equal(entry.Message, "console: plain output")
+}
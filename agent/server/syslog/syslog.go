@@ -2,19 +2,29 @@ package syslog
 
 import (
 	"bufio"
+	"context"
+	"errors"
+	"github.com/csnewman/droidmole/agent/util/ringbuffer"
 	"log"
 	"net"
 	"os"
-	"sync"
-	"sync/atomic"
+	"strings"
 )
 
 const SockAddr = "/tmp/sys-shell.sock"
 
+const (
+	// backlogSize is the number of entries retained for replay
+	backlogSize = 5000
+
+	// listenerLimit is the number of entries queued for a listener before entries are dropped. Matches the backlog, so
+	// the whole backlog can be replayed
+	listenerLimit = backlogSize
+)
+
 type SysLog struct {
 	listener net.Listener
-	clients  []*Listener
-	mu       sync.Mutex
+	entries  *ringbuffer.Buffer[*Entry]
 }
 
 func Start() (*SysLog, error) {
@@ -30,7 +40,7 @@ func Start() (*SysLog, error) {
 
 	s := &SysLog{
 		listener: l,
-		clients:  []*Listener{},
+		entries:  ringbuffer.New[*Entry](backlogSize),
 	}
 
 	go s.processor()
@@ -38,14 +48,18 @@ func Start() (*SysLog, error) {
 	return s, nil
 }
 
+// Close stops accepting connections, removing the socket, and closes all listeners.
 func (s *SysLog) Close() {
 	s.listener.Close()
+	s.entries.Close()
 }
 
 func (s *SysLog) processor() {
 	for {
 		conn, err := s.listener.Accept()
-		if err != nil {
+		if errors.Is(err, net.ErrClosed) {
+			return
+		} else if err != nil {
 			log.Println("syslog accept error:", err)
 			return
 		}
@@ -54,19 +68,7 @@ func (s *SysLog) processor() {
 
 		scanner := bufio.NewScanner(conn)
 		for scanner.Scan() {
-			msg := scanner.Text()
-
-			s.mu.Lock()
-
-			for i := len(s.clients) - 1; i >= 0; i-- {
-				l := s.clients[i]
-
-				if !l.send(msg) {
-					s.clients = append(s.clients[:i], s.clients[i+1:]...)
-				}
-			}
-
-			s.mu.Unlock()
+			s.entries.Append(ParseLine(strings.TrimRight(scanner.Text(), "\r")))
 		}
 
 		if err := scanner.Err(); err != nil {
@@ -76,41 +78,31 @@ func (s *SysLog) processor() {
 }
 
 type Listener struct {
-	channel chan string
-	closed  atomic.Bool
+	subscriber *ringbuffer.Subscriber[*Entry]
 }
 
-func (s *SysLog) Listen() *Listener {
-	channel := make(chan string, 100)
-	listener := &Listener{
-		channel: channel,
+// Listen creates a listener for new entries. When replay is set, the retained recent entries are received first.
+func (s *SysLog) Listen(replay bool) *Listener {
+	return &Listener{
+		subscriber: s.entries.Subscribe(listenerLimit, replay),
 	}
-
-	s.mu.Lock()
-	s.clients = append(s.clients, listener)
-	s.mu.Unlock()
-
-	return listener
 }
 
-func (l *Listener) send(msg string) bool {
-	if l.closed.Load() {
-		return false
-	}
-
-	select {
-	case l.channel <- msg:
-	default:
-		log.Println("syslog listener full - dropping message")
+// Next returns the next entry, or false once the listener has been closed or the context is cancelled.
+func (l *Listener) Next(ctx context.Context) (*Entry, bool) {
+	entry, err := l.subscriber.Next(ctx)
+	if err != nil {
+		return nil, false
 	}
 
-	return true
+	return entry, true
 }
 
-func (l *Listener) Recv() string {
-	return <-l.channel
+// Dropped returns the number of entries dropped as the listener fell behind.
+func (l *Listener) Dropped() uint64 {
+	return l.subscriber.Dropped()
 }
 
 func (l *Listener) Close() {
-	l.closed.Store(true)
+	l.subscriber.Close()
 }
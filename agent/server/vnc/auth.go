@@ -0,0 +1,33 @@
+package vnc
+
+import (
+	"crypto/des"
+	"crypto/subtle"
+)
+
+// checkResponse verifies a VNC authentication response, which is the challenge encrypted with DES using the password
+// as the key. Only the first 8 characters of the password are significant.
+func checkResponse(password string, challenge []byte, response []byte) bool {
+	key := make([]byte, 8)
+	copy(key, password)
+
+	// Each key byte is used with its bits reversed
+	for i, b := range key {
+		var reversed byte
+		for bit := 0; bit < 8; bit++ {
+			reversed = reversed<<1 | (b>>bit)&1
+		}
+		key[i] = reversed
+	}
+
+	cipher, err := des.NewCipher(key)
+	if err != nil {
+		return false
+	}
+
+	expected := make([]byte, 16)
+	cipher.Encrypt(expected[:8], challenge[:8])
+	cipher.Encrypt(expected[8:], challenge[8:])
+
+	return subtle.ConstantTimeCompare(expected, response) == 1
+}
@@ -0,0 +1,246 @@
+package vnc
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+)
+
+// pixelFormat represents the format the client expects pixel data in.
+type pixelFormat struct {
+	bitsPerPixel uint8
+	depth        uint8
+	bigEndian    bool
+	trueColour   bool
+	redMax       uint16
+	greenMax     uint16
+	blueMax      uint16
+	redShift     uint8
+	greenShift   uint8
+	blueShift    uint8
+}
+
+var defaultPixelFormat = pixelFormat{
+	bitsPerPixel: 32,
+	depth:        24,
+	trueColour:   true,
+	redMax:       255,
+	greenMax:     255,
+	blueMax:      255,
+	redShift:     16,
+	greenShift:   8,
+	blueShift:    0,
+}
+
+func parsePixelFormat(data []byte) (pixelFormat, error) {
+	format := pixelFormat{
+		bitsPerPixel: data[0],
+		depth:        data[1],
+		bigEndian:    data[2] != 0,
+		trueColour:   data[3] != 0,
+		redMax:       binary.BigEndian.Uint16(data[4:]),
+		greenMax:     binary.BigEndian.Uint16(data[6:]),
+		blueMax:      binary.BigEndian.Uint16(data[8:]),
+		redShift:     data[10],
+		greenShift:   data[11],
+		blueShift:    data[12],
+	}
+
+	if format.bitsPerPixel != 8 && format.bitsPerPixel != 16 && format.bitsPerPixel != 32 {
+		return format, errors.New("unsupported bits per pixel")
+	}
+
+	if !format.trueColour {
+		return format, errors.New("colour map pixel formats are not supported")
+	}
+
+	return format, nil
+}
+
+func (f pixelFormat) marshal() []byte {
+	data := make([]byte, 16)
+	data[0] = f.bitsPerPixel
+	data[1] = f.depth
+
+	if f.bigEndian {
+		data[2] = 1
+	}
+
+	if f.trueColour {
+		data[3] = 1
+	}
+
+	binary.BigEndian.PutUint16(data[4:], f.redMax)
+	binary.BigEndian.PutUint16(data[6:], f.greenMax)
+	binary.BigEndian.PutUint16(data[8:], f.blueMax)
+	data[10] = f.redShift
+	data[11] = f.greenShift
+	data[12] = f.blueShift
+
+	return data
+}
+
+func (f pixelFormat) value(r byte, g byte, b byte) uint32 {
+	return (uint32(r)*uint32(f.redMax)/255)<<f.redShift |
+		(uint32(g)*uint32(f.greenMax)/255)<<f.greenShift |
+		(uint32(b)*uint32(f.blueMax)/255)<<f.blueShift
+}
+
+// appendPixel appends a pixel in the client's format.
+func (f pixelFormat) appendPixel(dst []byte, value uint32) []byte {
+	switch f.bitsPerPixel {
+	case 8:
+		return append(dst, byte(value))
+	case 16:
+		if f.bigEndian {
+			return binary.BigEndian.AppendUint16(dst, uint16(value))
+		}
+		return binary.LittleEndian.AppendUint16(dst, uint16(value))
+	default:
+		if f.bigEndian {
+			return binary.BigEndian.AppendUint32(dst, value)
+		}
+		return binary.LittleEndian.AppendUint32(dst, value)
+	}
+}
+
+// appendCPixel appends a pixel in the compressed form used by ZRLE, where 32-bit pixels with a depth of at most 24
+// omit the unused byte.
+func (f pixelFormat) appendCPixel(dst []byte, value uint32) []byte {
+	if f.bitsPerPixel != 32 || f.depth > 24 {
+		return f.appendPixel(dst, value)
+	}
+
+	used := uint32(f.redMax)<<f.redShift | uint32(f.greenMax)<<f.greenShift | uint32(f.blueMax)<<f.blueShift
+
+	// Determine whether the colours fit within the least significant bytes
+	low := used <= 0xFFFFFF
+	if !low && used&0xFF != 0 {
+		return f.appendPixel(dst, value)
+	}
+
+	full := f.appendPixel(nil, value)
+	if low != f.bigEndian {
+		return append(dst, full[:3]...)
+	}
+
+	return append(dst, full[1:]...)
+}
+
+func encodeRaw(b *bytes.Buffer, canvas []byte, stride int, r rect, format pixelFormat) {
+	pixels := make([]byte, 0, r.w*int(format.bitsPerPixel)/8)
+
+	for y := r.y; y < r.y+r.h; y++ {
+		pixels = pixels[:0]
+
+		for x := r.x; x < r.x+r.w; x++ {
+			offset := 3 * (y*stride + x)
+			pixels = format.appendPixel(pixels, format.value(canvas[offset], canvas[offset+1], canvas[offset+2]))
+		}
+
+		b.Write(pixels)
+	}
+}
+
+// zrleEncoder encodes rectangles using ZRLE. A single zlib stream is shared by all rectangles of a connection.
+type zrleEncoder struct {
+	compressed bytes.Buffer
+	zw         *zlib.Writer
+	tile       []byte
+	pixels     []uint32
+}
+
+func newZRLEEncoder() *zrleEncoder {
+	e := &zrleEncoder{}
+	e.zw = zlib.NewWriter(&e.compressed)
+	return e
+}
+
+func (e *zrleEncoder) encode(b *bytes.Buffer, canvas []byte, stride int, r rect, format pixelFormat) {
+	for ty := r.y; ty < r.y+r.h; ty += tileSize {
+		for tx := r.x; tx < r.x+r.w; tx += tileSize {
+			tile := rect{x: tx, y: ty, w: min(tileSize, r.x+r.w-tx), h: min(tileSize, r.y+r.h-ty)}
+			_, _ = e.zw.Write(e.encodeTile(canvas, stride, tile, format))
+		}
+	}
+
+	_ = e.zw.Flush()
+
+	_ = binary.Write(b, binary.BigEndian, uint32(e.compressed.Len()))
+	b.Write(e.compressed.Bytes())
+	e.compressed.Reset()
+}
+
+// encodeTile encodes a single tile as a solid colour, a packed palette or raw pixels.
+func (e *zrleEncoder) encodeTile(canvas []byte, stride int, r rect, format pixelFormat) []byte {
+	e.pixels = e.pixels[:0]
+
+	var palette []uint32
+	indexes := make(map[uint32]int)
+
+	for y := r.y; y < r.y+r.h; y++ {
+		for x := r.x; x < r.x+r.w; x++ {
+			offset := 3 * (y*stride + x)
+			value := format.value(canvas[offset], canvas[offset+1], canvas[offset+2])
+			e.pixels = append(e.pixels, value)
+
+			if _, ok := indexes[value]; !ok && len(palette) <= 16 {
+				indexes[value] = len(palette)
+				palette = append(palette, value)
+			}
+		}
+	}
+
+	tile := e.tile[:0]
+
+	switch {
+	case len(palette) == 1:
+		tile = append(tile, 1)
+		tile = format.appendCPixel(tile, palette[0])
+
+	case len(palette) <= 16:
+		tile = append(tile, byte(len(palette)))
+		for _, value := range palette {
+			tile = format.appendCPixel(tile, value)
+		}
+
+		bits := 4
+		if len(palette) == 2 {
+			bits = 1
+		} else if len(palette) <= 4 {
+			bits = 2
+		}
+
+		// Rows are padded to a whole number of bytes
+		for y := 0; y < r.h; y++ {
+			var current byte
+			used := 0
+
+			for x := 0; x < r.w; x++ {
+				current = current<<bits | byte(indexes[e.pixels[y*r.w+x]])
+				used += bits
+
+				if used == 8 {
+					tile = append(tile, current)
+					current = 0
+					used = 0
+				}
+			}
+
+			if used > 0 {
+				tile = append(tile, current<<(8-used))
+			}
+		}
+
+	default:
+		tile = append(tile, 0)
+		for _, value := range e.pixels {
+			tile = format.appendCPixel(tile, value)
+		}
+	}
+
+	e.tile = tile
+
+	return tile
+}
@@ -0,0 +1,53 @@
+package vnc
+
+import "strconv"
+
+// keysyms maps X11 keysyms to w3c key values.
+var keysyms = map[uint32]string{
+	0xff08: "Backspace",
+	0xff09: "Tab",
+	0xff0d: "Enter",
+	0xff13: "Pause",
+	0xff1b: "Escape",
+	0xff50: "Home",
+	0xff51: "ArrowLeft",
+	0xff52: "ArrowUp",
+	0xff53: "ArrowRight",
+	0xff54: "ArrowDown",
+	0xff55: "PageUp",
+	0xff56: "PageDown",
+	0xff57: "End",
+	0xff63: "Insert",
+	0xff67: "ContextMenu",
+	0xff8d: "Enter",
+	0xffe1: "Shift",
+	0xffe2: "Shift",
+	0xffe3: "Control",
+	0xffe4: "Control",
+	0xffe5: "CapsLock",
+	0xffe7: "Meta",
+	0xffe8: "Meta",
+	0xffe9: "Alt",
+	0xffea: "Alt",
+	0xffeb: "Meta",
+	0xffec: "Meta",
+	0xffff: "Delete",
+}
+
+// keysymToKey converts an X11 keysym into a w3c key value.
+func keysymToKey(keysym uint32) (string, bool) {
+	switch {
+	case keysym >= 0x20 && keysym <= 0x7e, keysym >= 0xa0 && keysym <= 0xff:
+		// Latin-1 keysyms match their code points
+		return string(rune(keysym)), true
+	case keysym >= 0x01000100 && keysym <= 0x0110ffff:
+		// Unicode keysyms
+		return string(rune(keysym - 0x01000000)), true
+	case keysym >= 0xffbe && keysym <= 0xffc9:
+		// Function keys F1 to F12
+		return "F" + strconv.Itoa(int(keysym-0xffbe+1)), true
+	}
+
+	key, ok := keysyms[keysym]
+	return key, ok
+}
@@ -0,0 +1,349 @@
+package vnc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/csnewman/droidmole/agent/server/emulator"
+	"time"
+)
+
+const (
+	tileSize = 64
+
+	// Minimum number of rows a scrolled region must span to be sent as a CopyRect
+	minCopyRows = 16
+)
+
+// rect represents a region of the framebuffer.
+type rect struct {
+	x int
+	y int
+	w int
+	h int
+}
+
+// sendUpdates serves update requests, waiting for the display to change for incremental requests.
+func (c *connection) sendUpdates() error {
+	ticker := time.NewTicker(time.Second / maxFps)
+	defer ticker.Stop()
+
+	zrle := newZRLEEncoder()
+
+	for {
+		select {
+		case <-c.requests:
+		case <-c.done:
+			return nil
+		}
+
+		for {
+			frame, err := c.listener.Wait()
+			if err != nil {
+				return err
+			}
+
+			if c.closed.Load() {
+				return nil
+			}
+
+			c.mu.Lock()
+			full := c.fullRequested
+			c.fullRequested = false
+			format := c.format
+			encodings := c.encodings
+			c.mu.Unlock()
+
+			update := c.buildUpdate(frame, full, format, encodings, zrle)
+			if update == nil {
+				continue
+			}
+
+			_, err = c.conn.Write(update)
+			if err != nil {
+				return err
+			}
+
+			break
+		}
+
+		select {
+		case <-ticker.C:
+		case <-c.done:
+			return nil
+		}
+	}
+}
+
+// buildUpdate produces a framebuffer update message bringing the client up to date with the given frame. A nil
+// result signifies the client is already up to date.
+func (c *connection) buildUpdate(
+	frame *emulator.Frame,
+	full bool,
+	format pixelFormat,
+	encodings []int32,
+	zrle *zrleEncoder,
+) []byte {
+	body := &bytes.Buffer{}
+	count := 0
+
+	// Follow display size changes when supported, otherwise the display is clipped to the initial size
+	if frame != nil && (int(frame.Width) != c.width || int(frame.Height) != c.height) &&
+		supportsEncoding(encodings, encodingDesktopSize) {
+		c.width = int(frame.Width)
+		c.height = int(frame.Height)
+		c.fb = make([]byte, 3*c.width*c.height)
+		full = true
+
+		writeRectHeader(body, rect{w: c.width, h: c.height}, encodingDesktopSize)
+		count++
+	}
+
+	canvas := c.canvas(frame)
+
+	var dirty []rect
+	if full {
+		dirty = []rect{{w: c.width, h: c.height}}
+	} else {
+		dirty = c.dirtyRects(canvas)
+
+		if len(dirty) > 0 && supportsEncoding(encodings, encodingCopyRect) {
+			if copied, srcY, ok := c.detectScroll(canvas, boundingRect(dirty)); ok {
+				writeRectHeader(body, copied, encodingCopyRect)
+				_ = binary.Write(body, binary.BigEndian, uint16(copied.x))
+				_ = binary.Write(body, binary.BigEndian, uint16(srcY))
+				count++
+
+				c.applyCopy(copied, srcY)
+				dirty = c.dirtyRects(canvas)
+			}
+		}
+	}
+
+	if count == 0 && len(dirty) == 0 {
+		return nil
+	}
+
+	useZRLE := preferredEncoding(encodings) == encodingZRLE
+
+	for _, r := range dirty {
+		if useZRLE {
+			writeRectHeader(body, r, encodingZRLE)
+			zrle.encode(body, canvas, c.width, r, format)
+		} else {
+			writeRectHeader(body, r, encodingRaw)
+			encodeRaw(body, canvas, c.width, r, format)
+		}
+
+		count++
+	}
+
+	copy(c.fb, canvas)
+
+	msg := &bytes.Buffer{}
+	msg.WriteByte(msgFramebufferUpdate)
+	msg.WriteByte(0)
+	_ = binary.Write(msg, binary.BigEndian, uint16(count))
+	msg.Write(body.Bytes())
+
+	return msg.Bytes()
+}
+
+// canvas returns the frame fitted to the framebuffer size. A nil frame produces a blank canvas.
+func (c *connection) canvas(frame *emulator.Frame) []byte {
+	if frame != nil && int(frame.Width) == c.width && int(frame.Height) == c.height {
+		return frame.Data
+	}
+
+	canvas := make([]byte, 3*c.width*c.height)
+	if frame == nil {
+		return canvas
+	}
+
+	w := c.width
+	if int(frame.Width) < w {
+		w = int(frame.Width)
+	}
+
+	h := c.height
+	if int(frame.Height) < h {
+		h = int(frame.Height)
+	}
+
+	for y := 0; y < h; y++ {
+		copy(canvas[3*y*c.width:3*(y*c.width+w)], frame.Data[3*y*int(frame.Width):])
+	}
+
+	return canvas
+}
+
+// dirtyRects compares the canvas against the client framebuffer in tiles, merging adjacent tiles on the same row.
+func (c *connection) dirtyRects(canvas []byte) []rect {
+	var rects []rect
+
+	for ty := 0; ty < c.height; ty += tileSize {
+		th := min(tileSize, c.height-ty)
+		start := -1
+
+		for tx := 0; tx < c.width; tx += tileSize {
+			dirty := c.tileChanged(canvas, rect{x: tx, y: ty, w: min(tileSize, c.width-tx), h: th})
+
+			if dirty && start < 0 {
+				start = tx
+			} else if !dirty && start >= 0 {
+				rects = append(rects, rect{x: start, y: ty, w: tx - start, h: th})
+				start = -1
+			}
+		}
+
+		if start >= 0 {
+			rects = append(rects, rect{x: start, y: ty, w: c.width - start, h: th})
+		}
+	}
+
+	return rects
+}
+
+func (c *connection) tileChanged(canvas []byte, r rect) bool {
+	for y := r.y; y < r.y+r.h; y++ {
+		offset := 3 * (y*c.width + r.x)
+		if !bytes.Equal(canvas[offset:offset+3*r.w], c.fb[offset:offset+3*r.w]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// detectScroll finds the largest run of rows within the region that has moved vertically since the last update.
+func (c *connection) detectScroll(canvas []byte, region rect) (rect, int, bool) {
+	row := func(data []byte, y int) []byte {
+		offset := 3 * (y*c.width + region.x)
+		return data[offset : offset+3*region.w]
+	}
+
+	// Index the previous rows by content
+	previous := make(map[string]int)
+	for y := region.y; y < region.y+region.h; y++ {
+		key := string(row(c.fb, y))
+		if _, ok := previous[key]; !ok {
+			previous[key] = y
+		}
+	}
+
+	// Find the most common displacement
+	votes := make(map[int]int)
+	for y := region.y; y < region.y+region.h; y++ {
+		if src, ok := previous[string(row(canvas, y))]; ok && src != y {
+			votes[y-src]++
+		}
+	}
+
+	dy, best := 0, 0
+	for d, count := range votes {
+		if count > best {
+			dy, best = d, count
+		}
+	}
+
+	if best < minCopyRows {
+		return rect{}, 0, false
+	}
+
+	// Find the longest run of rows matching the displacement
+	runStart, runLen := 0, 0
+	start := -1
+
+	for y := region.y; y <= region.y+region.h; y++ {
+		src := y - dy
+		matches := y < region.y+region.h && src >= 0 && src < c.height &&
+			bytes.Equal(row(canvas, y), row(c.fb, src))
+
+		if matches && start < 0 {
+			start = y
+		} else if !matches && start >= 0 {
+			if y-start > runLen {
+				runStart, runLen = start, y-start
+			}
+			start = -1
+		}
+	}
+
+	if runLen < minCopyRows {
+		return rect{}, 0, false
+	}
+
+	return rect{x: region.x, y: runStart, w: region.w, h: runLen}, runStart - dy, true
+}
+
+// applyCopy updates the client framebuffer to reflect a CopyRect.
+func (c *connection) applyCopy(r rect, srcY int) {
+	rows := make([]byte, 0, 3*r.w*r.h)
+	for y := 0; y < r.h; y++ {
+		offset := 3 * ((srcY+y)*c.width + r.x)
+		rows = append(rows, c.fb[offset:offset+3*r.w]...)
+	}
+
+	for y := 0; y < r.h; y++ {
+		offset := 3 * ((r.y+y)*c.width + r.x)
+		copy(c.fb[offset:offset+3*r.w], rows[3*r.w*y:])
+	}
+}
+
+func boundingRect(rects []rect) rect {
+	x0, y0 := rects[0].x, rects[0].y
+	x1, y1 := rects[0].x+rects[0].w, rects[0].y+rects[0].h
+
+	for _, r := range rects[1:] {
+		x0 = min(x0, r.x)
+		y0 = min(y0, r.y)
+		x1 = max(x1, r.x+r.w)
+		y1 = max(y1, r.y+r.h)
+	}
+
+	return rect{x: x0, y: y0, w: x1 - x0, h: y1 - y0}
+}
+
+func writeRectHeader(b *bytes.Buffer, r rect, encoding int32) {
+	_ = binary.Write(b, binary.BigEndian, uint16(r.x))
+	_ = binary.Write(b, binary.BigEndian, uint16(r.y))
+	_ = binary.Write(b, binary.BigEndian, uint16(r.w))
+	_ = binary.Write(b, binary.BigEndian, uint16(r.h))
+	_ = binary.Write(b, binary.BigEndian, encoding)
+}
+
+func supportsEncoding(encodings []int32, encoding int32) bool {
+	for _, e := range encodings {
+		if e == encoding {
+			return true
+		}
+	}
+
+	return false
+}
+
+// preferredEncoding returns the first supported pixel data encoding in the client's order of preference.
+func preferredEncoding(encodings []int32) int32 {
+	for _, e := range encodings {
+		if e == encodingZRLE || e == encodingRaw {
+			return e
+		}
+	}
+
+	return encodingRaw
+}
+
+func min(a int, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func max(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,520 @@
+package vnc
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/emulator"
+	"github.com/csnewman/droidmole/agent/util/broadcaster"
+	"go.uber.org/zap"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	protocolVersion = "RFB 003.008\n"
+	desktopName     = "droidmole"
+
+	securityNone    = 1
+	securityVNCAuth = 2
+
+	msgSetPixelFormat           = 0
+	msgSetEncodings             = 2
+	msgFramebufferUpdateRequest = 3
+	msgKeyEvent                 = 4
+	msgPointerEvent             = 5
+	msgClientCutText            = 6
+
+	msgFramebufferUpdate = 0
+
+	encodingRaw         = 0
+	encodingCopyRect    = 1
+	encodingZRLE        = 16
+	encodingDesktopSize = -223
+
+	// Size used before the display has produced a frame
+	defaultWidth  = 720
+	defaultHeight = 1280
+
+	// Touch identifiers are shared with other connections, so start well above those used by typical clients
+	touchIdentifierBase = 1000
+
+	maxFps       = 30
+	maxCutText   = 1 << 20
+	initTimeout  = 30 * time.Second
+	frameTimeout = 2 * time.Second
+)
+
+var errAuthFailed = errors.New("authentication failed")
+
+// InputHandler forwards an input event to the emulator.
+type InputHandler func(request *protocol.InputRequest) error
+
+// Server serves a display over the RFB protocol, allowing standard VNC clients to view and control the emulator.
+type Server struct {
+	log      *zap.SugaredLogger
+	password string
+	frames   *broadcaster.Broadcaster[*emulator.Frame]
+	input    InputHandler
+	nextConn atomic.Uint32
+}
+
+// New creates a server for the display frames produced by the given broadcaster. Leave the password empty to disable
+// authentication.
+func New(
+	log *zap.SugaredLogger,
+	password string,
+	frames *broadcaster.Broadcaster[*emulator.Frame],
+	input InputHandler,
+) *Server {
+	return &Server{
+		log:      log,
+		password: password,
+		frames:   frames,
+		input:    input,
+	}
+}
+
+// Serve accepts connections until the listener fails.
+func (s *Server) Serve(lis net.Listener) error {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return err
+		}
+
+		c := &connection{
+			server:   s,
+			conn:     conn,
+			r:        bufio.NewReader(conn),
+			listener: s.frames.Listener(),
+			touchId:  touchIdentifierBase + s.nextConn.Add(1),
+			format:   defaultPixelFormat,
+			done:     make(chan struct{}),
+			requests: make(chan struct{}, 1),
+		}
+
+		go c.run()
+	}
+}
+
+// connection represents a single RFB client.
+type connection struct {
+	server   *Server
+	conn     net.Conn
+	r        *bufio.Reader
+	listener *broadcaster.Listener[*emulator.Frame]
+	touchId  uint32
+	closed   atomic.Bool
+	done     chan struct{}
+	requests chan struct{}
+
+	mu            sync.Mutex
+	format        pixelFormat
+	encodings     []int32
+	fullRequested bool
+	buttonMask    uint8
+	touching      bool
+	touchX        uint16
+	touchY        uint16
+
+	width  int
+	height int
+	fb     []byte
+}
+
+func (c *connection) run() {
+	defer c.conn.Close()
+
+	log := c.server.log
+	log.Info("VNC client connected from ", c.conn.RemoteAddr())
+
+	err := c.handshake()
+	if err != nil {
+		log.Info("VNC handshake failed: ", err)
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		err := c.sendUpdates()
+		if err != nil && !c.closed.Load() {
+			log.Info("VNC update failed: ", err)
+		}
+
+		c.close()
+	}()
+
+	err = c.readMessages()
+	if err != nil && !c.closed.Load() && !errors.Is(err, io.EOF) {
+		log.Info("VNC client error: ", err)
+	}
+
+	c.close()
+	<-done
+
+	c.releaseTouch()
+
+	log.Info("VNC client disconnected from ", c.conn.RemoteAddr())
+}
+
+func (c *connection) close() {
+	if !c.closed.Swap(true) {
+		close(c.done)
+		c.conn.Close()
+		c.listener.Wake()
+	}
+}
+
+func (c *connection) handshake() error {
+	_ = c.conn.SetDeadline(time.Now().Add(initTimeout))
+	defer c.conn.SetDeadline(time.Time{})
+
+	_, err := io.WriteString(c.conn, protocolVersion)
+	if err != nil {
+		return err
+	}
+
+	version := make([]byte, 12)
+	_, err = io.ReadFull(c.r, version)
+	if err != nil {
+		return err
+	}
+
+	var major, minor int
+	_, err = fmt.Sscanf(string(version), "RFB %03d.%03d\n", &major, &minor)
+	if err != nil || major != 3 {
+		return fmt.Errorf("unsupported protocol version %q", version)
+	}
+
+	securityType := uint8(securityNone)
+	if c.server.password != "" {
+		securityType = securityVNCAuth
+	}
+
+	if minor < 7 {
+		// Version 3.3 only allows the server to decide
+		err = binary.Write(c.conn, binary.BigEndian, uint32(securityType))
+		if err != nil {
+			return err
+		}
+	} else {
+		_, err = c.conn.Write([]byte{1, securityType})
+		if err != nil {
+			return err
+		}
+
+		selected, err := c.r.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		if selected != securityType {
+			return c.securityFailure(minor, "unsupported security type")
+		}
+	}
+
+	if securityType == securityVNCAuth {
+		err = c.authenticate()
+		if errors.Is(err, errAuthFailed) {
+			return c.securityFailure(minor, err.Error())
+		} else if err != nil {
+			return err
+		}
+	}
+
+	// Version 3.8 always reports the result, earlier versions only after authentication
+	if minor >= 8 || securityType == securityVNCAuth {
+		err = binary.Write(c.conn, binary.BigEndian, uint32(0))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Shared flag is ignored, as all connections are shared
+	_, err = c.r.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	c.initFramebuffer()
+
+	init := &bytes.Buffer{}
+	_ = binary.Write(init, binary.BigEndian, uint16(c.width))
+	_ = binary.Write(init, binary.BigEndian, uint16(c.height))
+	init.Write(defaultPixelFormat.marshal())
+	_ = binary.Write(init, binary.BigEndian, uint32(len(desktopName)))
+	init.WriteString(desktopName)
+
+	_, err = c.conn.Write(init.Bytes())
+	return err
+}
+
+func (c *connection) authenticate() error {
+	challenge := make([]byte, 16)
+	_, err := rand.Read(challenge)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.conn.Write(challenge)
+	if err != nil {
+		return err
+	}
+
+	response := make([]byte, 16)
+	_, err = io.ReadFull(c.r, response)
+	if err != nil {
+		return err
+	}
+
+	if !checkResponse(c.server.password, challenge, response) {
+		return errAuthFailed
+	}
+
+	return nil
+}
+
+func (c *connection) securityFailure(minor int, reason string) error {
+	msg := &bytes.Buffer{}
+	_ = binary.Write(msg, binary.BigEndian, uint32(1))
+
+	// Reasons are only supported from version 3.8
+	if minor >= 8 {
+		_ = binary.Write(msg, binary.BigEndian, uint32(len(reason)))
+		msg.WriteString(reason)
+	}
+
+	_, _ = c.conn.Write(msg.Bytes())
+
+	return errors.New(reason)
+}
+
+// initFramebuffer sizes the framebuffer to the current display, waiting briefly for the first frame.
+func (c *connection) initFramebuffer() {
+	c.width = defaultWidth
+	c.height = defaultHeight
+
+	timer := time.AfterFunc(frameTimeout, c.listener.Wake)
+	frame, err := c.listener.Wait()
+	timer.Stop()
+
+	if err == nil && frame != nil {
+		c.width = int(frame.Width)
+		c.height = int(frame.Height)
+	}
+
+	// Ensure the first update request is served with the current frame
+	c.listener.Wake()
+
+	c.fb = make([]byte, 3*c.width*c.height)
+}
+
+func (c *connection) readMessages() error {
+	for {
+		kind, err := c.r.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		switch kind {
+		case msgSetPixelFormat:
+			msg := make([]byte, 19)
+			_, err = io.ReadFull(c.r, msg)
+			if err != nil {
+				return err
+			}
+
+			format, err := parsePixelFormat(msg[3:])
+			if err != nil {
+				return err
+			}
+
+			c.mu.Lock()
+			c.format = format
+			c.mu.Unlock()
+
+		case msgSetEncodings:
+			header := make([]byte, 3)
+			_, err = io.ReadFull(c.r, header)
+			if err != nil {
+				return err
+			}
+
+			encodings := make([]int32, binary.BigEndian.Uint16(header[1:]))
+			err = binary.Read(c.r, binary.BigEndian, encodings)
+			if err != nil {
+				return err
+			}
+
+			c.mu.Lock()
+			c.encodings = encodings
+			c.mu.Unlock()
+
+		case msgFramebufferUpdateRequest:
+			msg := make([]byte, 9)
+			_, err = io.ReadFull(c.r, msg)
+			if err != nil {
+				return err
+			}
+
+			c.requestUpdate(msg[0] == 0)
+
+		case msgKeyEvent:
+			msg := make([]byte, 7)
+			_, err = io.ReadFull(c.r, msg)
+			if err != nil {
+				return err
+			}
+
+			c.handleKey(msg[0] != 0, binary.BigEndian.Uint32(msg[3:]))
+
+		case msgPointerEvent:
+			msg := make([]byte, 5)
+			_, err = io.ReadFull(c.r, msg)
+			if err != nil {
+				return err
+			}
+
+			c.handlePointer(msg[0], binary.BigEndian.Uint16(msg[1:]), binary.BigEndian.Uint16(msg[3:]))
+
+		case msgClientCutText:
+			header := make([]byte, 7)
+			_, err = io.ReadFull(c.r, header)
+			if err != nil {
+				return err
+			}
+
+			length := binary.BigEndian.Uint32(header[3:])
+			if length > maxCutText {
+				return errors.New("cut text too large")
+			}
+
+			// Clipboard sharing is not supported
+			_, err = c.r.Discard(int(length))
+			if err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("unknown message type %d", kind)
+		}
+	}
+}
+
+func (c *connection) requestUpdate(full bool) {
+	c.mu.Lock()
+	c.fullRequested = c.fullRequested || full
+	c.mu.Unlock()
+
+	select {
+	case c.requests <- struct{}{}:
+	default:
+	}
+
+	// Full updates are served immediately with the current frame
+	if full {
+		c.listener.Wake()
+	}
+}
+
+func (c *connection) handleKey(down bool, keysym uint32) {
+	key, ok := keysymToKey(keysym)
+	if !ok {
+		return
+	}
+
+	eventType := protocol.KeyEvent_UP
+	if down {
+		eventType = protocol.KeyEvent_DOWN
+	}
+
+	c.sendInput(&protocol.InputRequest{
+		Event: &protocol.InputRequest_Key{
+			Key: &protocol.KeyEvent{
+				Type: eventType,
+				Key:  key,
+			},
+		},
+	})
+}
+
+// handlePointer maps the primary button to a touch and the secondary button to the back button.
+func (c *connection) handlePointer(mask uint8, x uint16, y uint16) {
+	c.mu.Lock()
+	previous := c.buttonMask
+	c.buttonMask = mask
+	wasTouching := c.touching
+	c.touching = mask&1 != 0
+	c.touchX = x
+	c.touchY = y
+	c.mu.Unlock()
+
+	if mask&1 != 0 || wasTouching {
+		var pressure uint32
+		if mask&1 != 0 {
+			pressure = 1
+		}
+
+		c.sendInput(&protocol.InputRequest{
+			Event: &protocol.InputRequest_Touch{
+				Touch: &protocol.TouchEvent{
+					Identifier: c.touchId,
+					X:          uint32(x),
+					Y:          uint32(y),
+					Pressure:   pressure,
+				},
+			},
+		})
+	}
+
+	if mask&4 != 0 && previous&4 == 0 {
+		c.sendInput(&protocol.InputRequest{
+			Event: &protocol.InputRequest_Key{
+				Key: &protocol.KeyEvent{
+					Type: protocol.KeyEvent_PRESS,
+					Key:  "GoBack",
+				},
+			},
+		})
+	}
+}
+
+// releaseTouch ends any touch left active by a disconnected client.
+func (c *connection) releaseTouch() {
+	c.mu.Lock()
+	touching := c.touching
+	c.touching = false
+	x, y := c.touchX, c.touchY
+	c.mu.Unlock()
+
+	if !touching {
+		return
+	}
+
+	c.sendInput(&protocol.InputRequest{
+		Event: &protocol.InputRequest_Touch{
+			Touch: &protocol.TouchEvent{
+				Identifier: c.touchId,
+				X:          uint32(x),
+				Y:          uint32(y),
+			},
+		},
+	})
+}
+
+func (c *connection) sendInput(request *protocol.InputRequest) {
+	err := c.server.input(request)
+	if err != nil {
+		c.server.log.Debug("VNC input dropped: ", err)
+	}
+}
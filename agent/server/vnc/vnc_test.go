@@ -0,0 +1,227 @@
+package vnc
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"crypto/des"
+	"encoding/binary"
+	"github.com/csnewman/droidmole/agent/protocol"
+	"github.com/csnewman/droidmole/agent/server/emulator"
+	"github.com/csnewman/droidmole/agent/util/broadcaster"
+	"github.com/matryer/is"
+	"go.uber.org/zap"
+	"io"
+	"net"
+	"testing"
+)
+
+func testFrame(width int, height int, row func(y int) byte) *emulator.Frame {
+	data := make([]byte, 3*width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			offset := 3 * (y*width + x)
+			data[offset] = row(y)
+			data[offset+1] = byte(x)
+			data[offset+2] = 0x80
+		}
+	}
+
+	return &emulator.Frame{Width: uint32(width), Height: uint32(height), Data: data}
+}
+
+func encryptChallenge(password string, challenge []byte) []byte {
+	key := make([]byte, 8)
+	copy(key, password)
+	for i, b := range key {
+		var reversed byte
+		for bit := 0; bit < 8; bit++ {
+			reversed = reversed<<1 | (b>>bit)&1
+		}
+		key[i] = reversed
+	}
+
+	cipher, _ := des.NewCipher(key)
+	response := make([]byte, 16)
+	cipher.Encrypt(response[:8], challenge[:8])
+	cipher.Encrypt(response[8:], challenge[8:])
+	return response
+}
+
+func TestServer(t *testing.T) {
+	is := is.New(t)
+
+	frames := broadcaster.New[*emulator.Frame]()
+	frames.Broadcast(testFrame(4, 2, func(y int) byte { return byte(y) }))
+
+	inputs := make(chan *protocol.InputRequest, 10)
+	server := New(zap.NewNop().Sugar(), "secret", frames, func(request *protocol.InputRequest) error {
+		inputs <- request
+		return nil
+	})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	is.NoErr(err)
+	defer lis.Close()
+
+	go server.Serve(lis)
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	is.NoErr(err)
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	read := func(n int) []byte {
+		data := make([]byte, n)
+		_, err := io.ReadFull(r, data)
+		is.NoErr(err)
+		return data
+	}
+
+	is.Equal(string(read(12)), "RFB 003.008\n")
+	_, err = conn.Write([]byte("RFB 003.008\n"))
+	is.NoErr(err)
+
+	is.Equal(read(2), []byte{1, securityVNCAuth})
+	_, err = conn.Write([]byte{securityVNCAuth})
+	is.NoErr(err)
+
+	challenge := read(16)
+	_, err = conn.Write(encryptChallenge("secret", challenge))
+	is.NoErr(err)
+	is.Equal(read(4), []byte{0, 0, 0, 0}) // security result
+
+	_, err = conn.Write([]byte{1})
+	is.NoErr(err)
+
+	init := read(24)
+	is.Equal(binary.BigEndian.Uint16(init[0:]), uint16(4))
+	is.Equal(binary.BigEndian.Uint16(init[2:]), uint16(2))
+	read(int(binary.BigEndian.Uint32(init[20:])))
+
+	// Raw encoding only, then request a full update
+	_, err = conn.Write([]byte{msgSetEncodings, 0, 0, 1, 0, 0, 0, 0})
+	is.NoErr(err)
+	_, err = conn.Write([]byte{msgFramebufferUpdateRequest, 0, 0, 0, 0, 0, 0, 4, 0, 2})
+	is.NoErr(err)
+
+	header := read(4)
+	is.Equal(header[0], byte(msgFramebufferUpdate))
+	is.Equal(binary.BigEndian.Uint16(header[2:]), uint16(1))
+
+	rect := read(12)
+	is.Equal(rect[:8], []byte{0, 0, 0, 0, 0, 4, 0, 2})
+	is.Equal(int32(binary.BigEndian.Uint32(rect[8:])), int32(encodingRaw))
+
+	pixels := read(4 * 4 * 2)
+	// Second row, second pixel in little endian BGRX
+	is.Equal(pixels[4*5:4*6], []byte{0x80, 1, 1, 0})
+
+	// Primary button maps to a touch
+	_, err = conn.Write([]byte{msgPointerEvent, 1, 0, 3, 0, 1})
+	is.NoErr(err)
+
+	touch := (<-inputs).GetTouch()
+	is.Equal(touch.X, uint32(3))
+	is.Equal(touch.Y, uint32(1))
+	is.Equal(touch.Pressure, uint32(1))
+
+	_, err = conn.Write([]byte{msgKeyEvent, 1, 0, 0, 0, 0, 0xff, 0x0d})
+	is.NoErr(err)
+
+	key := (<-inputs).GetKey()
+	is.Equal(key.Key, "Enter")
+	is.Equal(key.Type, protocol.KeyEvent_DOWN)
+}
+
+func TestServer_BadPassword(t *testing.T) {
+	is := is.New(t)
+
+	frames := broadcaster.New[*emulator.Frame]()
+	frames.Broadcast(nil)
+
+	server := New(zap.NewNop().Sugar(), "secret", frames, func(*protocol.InputRequest) error { return nil })
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	is.NoErr(err)
+	defer lis.Close()
+
+	go server.Serve(lis)
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	is.NoErr(err)
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	_, err = r.Discard(12)
+	is.NoErr(err)
+
+	_, err = conn.Write([]byte("RFB 003.008\n"))
+	is.NoErr(err)
+	_, err = r.Discard(2)
+	is.NoErr(err)
+	_, err = conn.Write([]byte{securityVNCAuth})
+	is.NoErr(err)
+
+	challenge := make([]byte, 16)
+	_, err = io.ReadFull(r, challenge)
+	is.NoErr(err)
+	_, err = conn.Write(encryptChallenge("wrong", challenge))
+	is.NoErr(err)
+
+	rest, _ := io.ReadAll(r)
+	is.Equal(rest[:4], []byte{0, 0, 0, 1})
+	is.True(bytes.Contains(rest, []byte("authentication failed")))
+}
+
+func TestBuildUpdate_CopyRect(t *testing.T) {
+	is := is.New(t)
+
+	width, height := 8, 64
+	before := testFrame(width, height, func(y int) byte { return byte(y) })
+	after := testFrame(width, height, func(y int) byte { return byte(y + 20) })
+
+	c := &connection{width: width, height: height, fb: make([]byte, 3*width*height)}
+	copy(c.fb, before.Data)
+
+	encodings := []int32{encodingCopyRect, encodingRaw}
+	update := c.buildUpdate(after, false, defaultPixelFormat, encodings, newZRLEEncoder())
+	is.True(update != nil)
+
+	// The scrolled rows are copied, leaving the revealed rows to be sent
+	is.True(binary.BigEndian.Uint16(update[2:]) >= 2)
+	is.Equal(update[4:12], []byte{0, 0, 0, 0, 0, 8, 0, 44})
+	is.Equal(int32(binary.BigEndian.Uint32(update[12:])), int32(encodingCopyRect))
+	is.Equal(update[16:20], []byte{0, 0, 0, 20})
+
+	is.Equal(c.fb, after.Data)
+	is.Equal(c.buildUpdate(after, false, defaultPixelFormat, encodings, newZRLEEncoder()), nil)
+}
+
+func TestZRLE(t *testing.T) {
+	is := is.New(t)
+
+	// Two colours, alternating by column
+	canvas := make([]byte, 3*4*2)
+	for i := 0; i < 8; i++ {
+		if i%2 == 1 {
+			canvas[3*i] = 0xFF
+		}
+	}
+
+	b := &bytes.Buffer{}
+	newZRLEEncoder().encode(b, canvas, 4, rect{w: 4, h: 2}, defaultPixelFormat)
+
+	length := binary.BigEndian.Uint32(b.Bytes())
+	is.Equal(int(length), b.Len()-4)
+
+	zr, err := zlib.NewReader(bytes.NewReader(b.Bytes()[4:]))
+	is.NoErr(err)
+
+	tile := make([]byte, 1+2*3+2)
+	_, err = io.ReadFull(zr, tile)
+	is.NoErr(err)
+
+	// Packed palette of two 3 byte pixels, with 1 bit per pixel padded per row
+	is.Equal(tile, []byte{2, 0, 0, 0, 0, 0, 0xFF, 0x50, 0x50})
+}
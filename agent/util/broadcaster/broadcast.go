@@ -1,6 +1,7 @@
 package broadcaster
 
 import (
+	"context"
 	"errors"
 	"sync"
 )
@@ -18,6 +19,7 @@ type Broadcaster[T interface{}] struct {
 type Listener[T interface{}] struct {
 	broadcaster    *Broadcaster[T]
 	lastGeneration uint64
+	woken          bool
 }
 
 func New[T interface{}]() *Broadcaster[T] {
@@ -31,12 +33,21 @@ func New[T interface{}]() *Broadcaster[T] {
 
 func (b *Broadcaster[T]) Broadcast(value T) {
 	b.mu.Lock()
+
+	// Listeners must not receive values after the broadcaster has closed
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+
 	b.generation++
 	b.value = value
 	b.cond.Broadcast()
 	b.mu.Unlock()
 }
 
+// Close wakes all listeners. Listeners receive the last value if they have not yet done so, after which waiting fails
+// with Closed.
 func (b *Broadcaster[T]) Close() {
 	b.mu.Lock()
 	b.closed = true
@@ -51,29 +62,58 @@ func (b *Broadcaster[T]) Listener() *Listener[T] {
 }
 
 func (l *Listener[T]) Wait() (T, error) {
+	return l.WaitContext(context.Background())
+}
+
+// WaitContext waits for a new value, returning the context error if the context is cancelled first.
+func (l *Listener[T]) WaitContext(ctx context.Context) (T, error) {
 	var result T
 	var err error
 
+	// Cond waits can not be cancelled, so wake the waiters once the context is done
+	if ctx.Done() != nil {
+		stop := make(chan struct{})
+		defer close(stop)
+
+		go func() {
+			select {
+			case <-ctx.Done():
+				l.broadcaster.mu.Lock()
+				l.broadcaster.cond.Broadcast()
+				l.broadcaster.mu.Unlock()
+			case <-stop:
+			}
+		}()
+	}
+
 	l.broadcaster.mu.Lock()
 
-	if l.broadcaster.closed {
+	for !l.broadcaster.closed && !l.woken && l.broadcaster.generation <= l.lastGeneration && ctx.Err() == nil {
+		l.broadcaster.cond.Wait()
+	}
+
+	// A value broadcast before the broadcaster closed is still received
+	pending := l.broadcaster.generation > l.lastGeneration
+
+	if ctx.Err() != nil {
+		err = ctx.Err()
+	} else if l.broadcaster.closed && !pending {
 		err = Closed
 	} else {
-		if l.broadcaster.generation <= l.lastGeneration {
-			l.broadcaster.cond.Wait()
-		}
-
-		if l.broadcaster.generation > l.lastGeneration {
-			l.lastGeneration = l.broadcaster.generation
-			result = l.broadcaster.value
-		} else if l.broadcaster.closed {
-			err = Closed
-		} else {
-			panic("broadcaster has inconsistent state")
-		}
+		l.woken = false
+		l.lastGeneration = l.broadcaster.generation
+		result = l.broadcaster.value
 	}
 
 	l.broadcaster.mu.Unlock()
 
 	return result, err
 }
+
+// Wake causes a pending or subsequent call to Wait to return the current value, even if it has already been received.
+func (l *Listener[T]) Wake() {
+	l.broadcaster.mu.Lock()
+	l.woken = true
+	l.broadcaster.cond.Broadcast()
+	l.broadcaster.mu.Unlock()
+}
@@ -0,0 +1,131 @@
+package broadcaster
+
+import (
+	"context"
+	"github.com/csnewman/droidmole/agent/util/testutil"
+	"github.com/matryer/is"
+	"testing"
+)
+
+func TestListener_Wait(t *testing.T) {
+	is := is.New(t)
+	b := New[int]()
+	l := b.Listener()
+
+	b.Broadcast(1)
+	b.Broadcast(2)
+
+	value, err := l.Wait()
+	is.NoErr(err)
+	is.Equal(value, 2)
+
+	is.NoErr(testutil.RunParallel(
+		t,
+		func(t *testing.T) {
+			is := is.New(t)
+			value, err := l.Wait()
+			is.NoErr(err)
+			is.Equal(value, 3)
+		},
+		func(t *testing.T) {
+			b.Broadcast(3)
+		},
+	))
+}
+
+func TestListener_Wake(t *testing.T) {
+	is := is.New(t)
+	b := New[int]()
+	l := b.Listener()
+	other := b.Listener()
+
+	b.Broadcast(1)
+
+	value, err := l.Wait()
+	is.NoErr(err)
+	is.Equal(value, 1)
+
+	value, err = other.Wait()
+	is.NoErr(err)
+	is.Equal(value, 1)
+
+	is.NoErr(testutil.RunParallel(
+		t,
+		func(t *testing.T) {
+			is := is.New(t)
+			value, err := l.Wait()
+			is.NoErr(err)
+			is.Equal(value, 1)
+		},
+		func(t *testing.T) {
+			l.Wake()
+		},
+	))
+
+	// Waking one listener must not release the others
+	l.Wake()
+	b.Broadcast(2)
+
+	value, err = other.Wait()
+	is.NoErr(err)
+	is.Equal(value, 2)
+}
+
+func TestListener_Close(t *testing.T) {
+	is := is.New(t)
+	b := New[int]()
+	l := b.Listener()
+
+	is.NoErr(testutil.RunParallel(
+		t,
+		func(t *testing.T) {
+			is := is.New(t)
+			_, err := l.Wait()
+			is.Equal(err, Closed)
+		},
+		func(t *testing.T) {
+			b.Close()
+		},
+	))
+
+	// The final value is received before the listener reports it is closed
+	b = New[int]()
+	l = b.Listener()
+
+	b.Broadcast(1)
+	b.Close()
+
+	value, err := l.Wait()
+	is.NoErr(err)
+	is.Equal(value, 1)
+
+	_, err = l.Wait()
+	is.Equal(err, Closed)
+}
+
+func TestListener_WaitContext(t *testing.T) {
+	is := is.New(t)
+	b := New[int]()
+	l := b.Listener()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	is.NoErr(testutil.RunParallel(
+		t,
+		func(t *testing.T) {
+			is := is.New(t)
+			_, err := l.WaitContext(ctx)
+			is.Equal(err, context.Canceled)
+		},
+		func(t *testing.T) {
+			cancel()
+		},
+	))
+
+	// The listener remains usable after a cancelled wait
+	b.Broadcast(1)
+
+	value, err := l.WaitContext(context.Background())
+	is.NoErr(err)
+	is.Equal(value, 1)
+}
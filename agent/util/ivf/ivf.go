@@ -0,0 +1,132 @@
+package ivf
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+// Codec represents the FourCC of the video stream.
+type Codec string
+
+const (
+	CodecVP8 Codec = "VP80"
+	CodecVP9 Codec = "VP90"
+)
+
+const (
+	headerSize      = 32
+	frameHeaderSize = 12
+	frameCountPos   = 24
+
+	// Timestamps are stored in milliseconds
+	timebaseDen = 1000
+	timebaseNum = 1
+)
+
+var ErrClosed = errors.New("writer closed")
+
+// Writer writes a single video stream into an IVF file.
+// If the underlying writer implements io.Seeker, the frame count is filled in when the writer is closed, otherwise it
+// is left as zero.
+type Writer struct {
+	w        io.Writer
+	offset   int64
+	frames   uint32
+	lastTime int64
+	closed   bool
+}
+
+// NewWriter writes the file header for a video stream with the given codec and size.
+func NewWriter(w io.Writer, codec Codec, width uint32, height uint32) (*Writer, error) {
+	wr := &Writer{
+		w: w,
+	}
+
+	header := make([]byte, headerSize)
+	copy(header[0:4], "DKIF")
+	binary.LittleEndian.PutUint16(header[4:], 0)
+	binary.LittleEndian.PutUint16(header[6:], headerSize)
+	copy(header[8:12], codec)
+	binary.LittleEndian.PutUint16(header[12:], uint16(width))
+	binary.LittleEndian.PutUint16(header[14:], uint16(height))
+	binary.LittleEndian.PutUint32(header[16:], timebaseDen)
+	binary.LittleEndian.PutUint32(header[20:], timebaseNum)
+
+	err := wr.write(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return wr, nil
+}
+
+// WriteFrame writes a single encoded frame, presented at the given time since the start of the video.
+// Timestamps must not decrease. IVF does not record keyframes, the flag is accepted for parity with other containers.
+func (w *Writer) WriteFrame(data []byte, keyframe bool, timestamp time.Duration) error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	ms := timestamp.Milliseconds()
+	if ms < w.lastTime {
+		ms = w.lastTime
+	}
+
+	w.lastTime = ms
+
+	header := make([]byte, frameHeaderSize)
+	binary.LittleEndian.PutUint32(header[0:], uint32(len(data)))
+	binary.LittleEndian.PutUint64(header[4:], uint64(ms))
+
+	err := w.write(header)
+	if err != nil {
+		return err
+	}
+
+	w.frames++
+
+	return w.write(data)
+}
+
+// Duration returns the timestamp of the last written frame.
+func (w *Writer) Duration() time.Duration {
+	return time.Duration(w.lastTime) * time.Millisecond
+}
+
+// Close fills in the frame count if possible. The underlying writer is not closed.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	w.closed = true
+
+	seeker, ok := w.w.(io.Seeker)
+	if !ok {
+		return nil
+	}
+
+	_, err := seeker.Seek(frameCountPos, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	count := make([]byte, 4)
+	binary.LittleEndian.PutUint32(count, w.frames)
+
+	_, err = w.w.Write(count)
+	if err != nil {
+		return err
+	}
+
+	_, err = seeker.Seek(w.offset, io.SeekStart)
+	return err
+}
+
+func (w *Writer) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.offset += int64(n)
+	return err
+}
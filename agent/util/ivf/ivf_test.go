@@ -0,0 +1,60 @@
+package ivf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/matryer/is"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWriter(t *testing.T) {
+	is := is.New(t)
+
+	path := filepath.Join(t.TempDir(), "out.ivf")
+	f, err := os.Create(path)
+	is.NoErr(err)
+
+	w, err := NewWriter(f, CodecVP8, 720, 1280)
+	is.NoErr(err)
+
+	is.NoErr(w.WriteFrame([]byte("key"), true, 0))
+	is.NoErr(w.WriteFrame([]byte("delta"), false, 40*time.Millisecond))
+	is.Equal(w.Duration(), 40*time.Millisecond)
+	is.NoErr(w.Close())
+	is.NoErr(f.Close())
+
+	data, err := os.ReadFile(path)
+	is.NoErr(err)
+
+	is.Equal(string(data[0:4]), "DKIF")
+	is.Equal(string(data[8:12]), "VP80")
+	is.Equal(binary.LittleEndian.Uint16(data[12:]), uint16(720))
+	is.Equal(binary.LittleEndian.Uint16(data[14:]), uint16(1280))
+	is.Equal(binary.LittleEndian.Uint32(data[24:]), uint32(2))
+
+	frames := data[headerSize:]
+	is.Equal(frames[:frameHeaderSize+3], []byte{3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 'k', 'e', 'y'})
+
+	frames = frames[frameHeaderSize+3:]
+	is.Equal(binary.LittleEndian.Uint32(frames), uint32(5))
+	is.Equal(binary.LittleEndian.Uint64(frames[4:]), uint64(40))
+	is.Equal(string(frames[frameHeaderSize:]), "delta")
+}
+
+func TestWriter_Unseekable(t *testing.T) {
+	is := is.New(t)
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, CodecVP9, 320, 240)
+	is.NoErr(err)
+
+	is.NoErr(w.WriteFrame([]byte("key"), true, 0))
+	is.NoErr(w.Close())
+	is.Equal(w.WriteFrame([]byte("late"), false, time.Second), ErrClosed)
+
+	// The frame count remains unknown
+	is.Equal(binary.LittleEndian.Uint32(buf.Bytes()[24:]), uint32(0))
+}
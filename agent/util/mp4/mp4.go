@@ -0,0 +1,502 @@
+package mp4
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+const (
+	// Timestamps are stored in milliseconds
+	timescale = 1000
+
+	// The duration given to the final sample when no other duration is known
+	defaultSampleDuration = 33
+
+	nalTypeSPS = 7
+	nalTypePPS = 8
+	nalTypeAUD = 9
+)
+
+var (
+	ErrClosed           = errors.New("writer closed")
+	ErrMissingParameter = errors.New("stream did not contain sps and pps")
+)
+
+type sample struct {
+	offset   int64
+	size     uint32
+	time     int64
+	keyframe bool
+}
+
+// description represents a sample description, describing the frame size and parameter sets of the samples from its
+// first sample onwards.
+type description struct {
+	width  uint32
+	height uint32
+	sps    []byte
+	pps    []byte
+	first  int
+}
+
+// Writer muxes a single H.264 video track into a MP4 container.
+// Samples are written as they are received, with the index written when the writer is closed.
+type Writer struct {
+	w            io.WriteSeeker
+	width        uint32
+	height       uint32
+	offset       int64
+	mdatPos      int64
+	descriptions []*description
+	samples      []sample
+	closed       bool
+}
+
+// NewWriter writes the container header for a H.264 video track of the given size.
+func NewWriter(w io.WriteSeeker, width uint32, height uint32) (*Writer, error) {
+	wr := &Writer{
+		w:      w,
+		width:  width,
+		height: height,
+		descriptions: []*description{
+			{
+				width:  width,
+				height: height,
+			},
+		},
+	}
+
+	header := &bytes.Buffer{}
+	writeBox(header, "ftyp", func(b *bytes.Buffer) {
+		b.WriteString("isom")
+		writeUint32(b, 0x200)
+		b.WriteString("isomiso2avc1mp41")
+	})
+
+	// The media data size is only known once all samples have been written
+	wr.mdatPos = int64(header.Len())
+	writeUint32(header, 1)
+	header.WriteString("mdat")
+	writeUint64(header, 0)
+
+	err := wr.write(header.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return wr, nil
+}
+
+// Resize changes the frame size. The following frames must start with a keyframe carrying the parameter sets of the
+// new size.
+func (w *Writer) Resize(width uint32, height uint32) {
+	w.width = width
+	w.height = height
+}
+
+// WriteFrame writes a single Annex B encoded frame, presented at the given time since the start of the video.
+// Timestamps must not decrease. Parameter sets are extracted from the stream and stored in the index, with a change of
+// parameter sets starting a new sample description.
+func (w *Writer) WriteFrame(data []byte, keyframe bool, timestamp time.Duration) error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	current := w.descriptions[len(w.descriptions)-1]
+	sps := current.sps
+	pps := current.pps
+
+	// Convert to length prefixed NAL units
+	payload := &bytes.Buffer{}
+	for _, nal := range splitNALs(data) {
+		switch nal[0] & 0x1F {
+		case nalTypeSPS:
+			sps = nal
+		case nalTypePPS:
+			pps = nal
+		case nalTypeAUD:
+		default:
+			writeUint32(payload, uint32(len(nal)))
+			payload.Write(nal)
+		}
+	}
+
+	w.describe(sps, pps)
+
+	if payload.Len() == 0 {
+		return nil
+	}
+
+	ms := timestamp.Milliseconds()
+	if len(w.samples) > 0 && ms < w.samples[len(w.samples)-1].time {
+		ms = w.samples[len(w.samples)-1].time
+	}
+
+	w.samples = append(w.samples, sample{
+		offset:   w.offset,
+		size:     uint32(payload.Len()),
+		time:     ms,
+		keyframe: keyframe,
+	})
+
+	return w.write(payload.Bytes())
+}
+
+// describe updates the current sample description, starting a new description if the parameter sets or frame size of
+// samples already written differ.
+func (w *Writer) describe(sps []byte, pps []byte) {
+	current := w.descriptions[len(w.descriptions)-1]
+
+	if bytes.Equal(sps, current.sps) && bytes.Equal(pps, current.pps) &&
+		w.width == current.width && w.height == current.height {
+		return
+	}
+
+	if current.first < len(w.samples) {
+		current = &description{
+			first: len(w.samples),
+		}
+		w.descriptions = append(w.descriptions, current)
+	}
+
+	current.width = w.width
+	current.height = w.height
+	current.sps = sps
+	current.pps = pps
+}
+
+// Duration returns the timestamp of the last written frame.
+func (w *Writer) Duration() time.Duration {
+	if len(w.samples) == 0 {
+		return 0
+	}
+
+	return time.Duration(w.samples[len(w.samples)-1].time) * time.Millisecond
+}
+
+// Close completes the media data and writes the index. The underlying writer is not closed.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	w.closed = true
+
+	for _, d := range w.descriptions {
+		if d.first < len(w.samples) && (d.sps == nil || d.pps == nil) {
+			return ErrMissingParameter
+		}
+	}
+
+	// Complete media data size
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(w.offset-w.mdatPos))
+
+	_, err := w.w.Seek(w.mdatPos+8, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.w.Write(size)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.w.Seek(w.offset, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	moov := &bytes.Buffer{}
+	w.writeMoov(moov)
+
+	return w.write(moov.Bytes())
+}
+
+func (w *Writer) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.offset += int64(n)
+	return err
+}
+
+func (w *Writer) sampleDurations() []uint32 {
+	durations := make([]uint32, len(w.samples))
+	for i := range w.samples {
+		if i+1 < len(w.samples) {
+			durations[i] = uint32(w.samples[i+1].time - w.samples[i].time)
+		} else if i > 0 {
+			durations[i] = durations[i-1]
+		} else {
+			durations[i] = defaultSampleDuration
+		}
+	}
+
+	return durations
+}
+
+func (w *Writer) writeMoov(b *bytes.Buffer) {
+	durations := w.sampleDurations()
+
+	var duration uint32
+	for _, d := range durations {
+		duration += d
+	}
+
+	writeBox(b, "moov", func(b *bytes.Buffer) {
+		writeFullBox(b, "mvhd", 0, 0, func(b *bytes.Buffer) {
+			writeUint32(b, 0) // creation time
+			writeUint32(b, 0) // modification time
+			writeUint32(b, timescale)
+			writeUint32(b, duration)
+			writeUint32(b, 0x00010000) // rate
+			writeUint16(b, 0x0100)     // volume
+			b.Write(make([]byte, 10))
+			writeMatrix(b)
+			b.Write(make([]byte, 24))
+			writeUint32(b, 2) // next track id
+		})
+
+		writeBox(b, "trak", func(b *bytes.Buffer) {
+			// Enabled and in movie
+			writeFullBox(b, "tkhd", 0, 3, func(b *bytes.Buffer) {
+				writeUint32(b, 0) // creation time
+				writeUint32(b, 0) // modification time
+				writeUint32(b, 1) // track id
+				writeUint32(b, 0)
+				writeUint32(b, duration)
+				b.Write(make([]byte, 8))
+				writeUint16(b, 0) // layer
+				writeUint16(b, 0) // alternate group
+				writeUint16(b, 0) // volume
+				writeUint16(b, 0)
+				writeMatrix(b)
+				writeUint32(b, w.descriptions[0].width<<16)
+				writeUint32(b, w.descriptions[0].height<<16)
+			})
+
+			writeBox(b, "mdia", func(b *bytes.Buffer) {
+				writeFullBox(b, "mdhd", 0, 0, func(b *bytes.Buffer) {
+					writeUint32(b, 0) // creation time
+					writeUint32(b, 0) // modification time
+					writeUint32(b, timescale)
+					writeUint32(b, duration)
+					writeUint16(b, 0x55C4) // undetermined language
+					writeUint16(b, 0)
+				})
+
+				writeFullBox(b, "hdlr", 0, 0, func(b *bytes.Buffer) {
+					writeUint32(b, 0)
+					b.WriteString("vide")
+					b.Write(make([]byte, 12))
+					b.WriteString("VideoHandler\x00")
+				})
+
+				writeBox(b, "minf", func(b *bytes.Buffer) {
+					writeFullBox(b, "vmhd", 0, 1, func(b *bytes.Buffer) {
+						b.Write(make([]byte, 8))
+					})
+
+					writeBox(b, "dinf", func(b *bytes.Buffer) {
+						writeFullBox(b, "dref", 0, 0, func(b *bytes.Buffer) {
+							writeUint32(b, 1)
+							// Media data is contained in the same file
+							writeFullBox(b, "url ", 0, 1, func(b *bytes.Buffer) {})
+						})
+					})
+
+					writeBox(b, "stbl", func(b *bytes.Buffer) {
+						w.writeSampleTable(b, durations)
+					})
+				})
+			})
+		})
+	})
+}
+
+func (w *Writer) writeSampleTable(b *bytes.Buffer, durations []uint32) {
+	writeFullBox(b, "stsd", 0, 0, func(b *bytes.Buffer) {
+		writeUint32(b, uint32(len(w.descriptions)))
+		for _, d := range w.descriptions {
+			writeDescription(b, d)
+		}
+	})
+
+	// Run length encoded sample durations
+	writeFullBox(b, "stts", 0, 0, func(b *bytes.Buffer) {
+		var entries [][2]uint32
+		for _, d := range durations {
+			if len(entries) > 0 && entries[len(entries)-1][1] == d {
+				entries[len(entries)-1][0]++
+			} else {
+				entries = append(entries, [2]uint32{1, d})
+			}
+		}
+
+		writeUint32(b, uint32(len(entries)))
+		for _, entry := range entries {
+			writeUint32(b, entry[0])
+			writeUint32(b, entry[1])
+		}
+	})
+
+	writeFullBox(b, "stss", 0, 0, func(b *bytes.Buffer) {
+		var keyframes []uint32
+		for i, s := range w.samples {
+			if s.keyframe {
+				keyframes = append(keyframes, uint32(i+1))
+			}
+		}
+
+		writeUint32(b, uint32(len(keyframes)))
+		for _, k := range keyframes {
+			writeUint32(b, k)
+		}
+	})
+
+	writeFullBox(b, "stsz", 0, 0, func(b *bytes.Buffer) {
+		writeUint32(b, 0)
+		writeUint32(b, uint32(len(w.samples)))
+		for _, s := range w.samples {
+			writeUint32(b, s.size)
+		}
+	})
+
+	// One sample per chunk, with each run of chunks referencing its sample description
+	writeFullBox(b, "stsc", 0, 0, func(b *bytes.Buffer) {
+		var entries [][3]uint32
+		for i, d := range w.descriptions {
+			if d.first < len(w.samples) || len(entries) == 0 {
+				entries = append(entries, [3]uint32{uint32(d.first + 1), 1, uint32(i + 1)})
+			}
+		}
+
+		writeUint32(b, uint32(len(entries)))
+		for _, entry := range entries {
+			writeUint32(b, entry[0])
+			writeUint32(b, entry[1])
+			writeUint32(b, entry[2])
+		}
+	})
+
+	writeFullBox(b, "co64", 0, 0, func(b *bytes.Buffer) {
+		writeUint32(b, uint32(len(w.samples)))
+		for _, s := range w.samples {
+			writeUint64(b, uint64(s.offset))
+		}
+	})
+}
+
+// writeDescription writes the avc1 sample entry of a sample description.
+func writeDescription(b *bytes.Buffer, d *description) {
+	writeBox(b, "avc1", func(b *bytes.Buffer) {
+		b.Write(make([]byte, 6))
+		writeUint16(b, 1) // data reference index
+		b.Write(make([]byte, 16))
+		writeUint16(b, uint16(d.width))
+		writeUint16(b, uint16(d.height))
+		writeUint32(b, 0x00480000) // 72 dpi
+		writeUint32(b, 0x00480000)
+		writeUint32(b, 0)
+		writeUint16(b, 1) // frame count
+		b.Write(make([]byte, 32))
+		writeUint16(b, 0x0018) // depth
+		writeUint16(b, 0xFFFF)
+
+		writeBox(b, "avcC", func(b *bytes.Buffer) {
+			b.WriteByte(1)
+			if len(d.sps) >= 4 {
+				b.Write(d.sps[1:4])
+			} else {
+				b.Write(make([]byte, 3))
+			}
+			b.WriteByte(0xFF) // 4 byte lengths
+			b.WriteByte(0xE1) // 1 sps
+			writeUint16(b, uint16(len(d.sps)))
+			b.Write(d.sps)
+			b.WriteByte(1) // 1 pps
+			writeUint16(b, uint16(len(d.pps)))
+			b.Write(d.pps)
+		})
+	})
+}
+
+// splitNALs splits an Annex B byte stream into NAL units, excluding start codes.
+func splitNALs(data []byte) [][]byte {
+	var nals [][]byte
+
+	start := -1
+	for i := 0; i+2 < len(data); i++ {
+		if data[i] != 0 || data[i+1] != 0 || data[i+2] != 1 {
+			continue
+		}
+
+		if start >= 0 {
+			nals = appendNAL(nals, data[start:i])
+		}
+
+		start = i + 3
+		i += 2
+	}
+
+	if start >= 0 {
+		nals = appendNAL(nals, data[start:])
+	}
+
+	return nals
+}
+
+func appendNAL(nals [][]byte, nal []byte) [][]byte {
+	// Remove the leading zero of a 4 byte start code
+	for len(nal) > 0 && nal[len(nal)-1] == 0 {
+		nal = nal[:len(nal)-1]
+	}
+
+	if len(nal) == 0 {
+		return nals
+	}
+
+	return append(nals, nal)
+}
+
+func writeBox(b *bytes.Buffer, kind string, body func(b *bytes.Buffer)) {
+	inner := &bytes.Buffer{}
+	body(inner)
+
+	writeUint32(b, uint32(8+inner.Len()))
+	b.WriteString(kind)
+	b.Write(inner.Bytes())
+}
+
+func writeFullBox(b *bytes.Buffer, kind string, version byte, flags uint32, body func(b *bytes.Buffer)) {
+	writeBox(b, kind, func(b *bytes.Buffer) {
+		writeUint32(b, uint32(version)<<24|flags&0xFFFFFF)
+		body(b)
+	})
+}
+
+func writeMatrix(b *bytes.Buffer) {
+	for _, v := range []uint32{0x00010000, 0, 0, 0, 0x00010000, 0, 0, 0, 0x40000000} {
+		writeUint32(b, v)
+	}
+}
+
+func writeUint16(b *bytes.Buffer, v uint16) {
+	encoded := make([]byte, 2)
+	binary.BigEndian.PutUint16(encoded, v)
+	b.Write(encoded)
+}
+
+func writeUint32(b *bytes.Buffer, v uint32) {
+	encoded := make([]byte, 4)
+	binary.BigEndian.PutUint32(encoded, v)
+	b.Write(encoded)
+}
+
+func writeUint64(b *bytes.Buffer, v uint64) {
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, v)
+	b.Write(encoded)
+}
@@ -0,0 +1,183 @@
+package mp4
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/matryer/is"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type box struct {
+	kind string
+	data []byte
+}
+
+// readBoxes parses a sequence of boxes with 32-bit or 64-bit sizes.
+func readBoxes(t *testing.T, data []byte) []box {
+	is := is.New(t)
+
+	var boxes []box
+	for len(data) > 0 {
+		size := uint64(binary.BigEndian.Uint32(data))
+		kind := string(data[4:8])
+		header := uint64(8)
+
+		if size == 1 {
+			size = binary.BigEndian.Uint64(data[8:])
+			header = 16
+		}
+
+		is.True(size >= header && size <= uint64(len(data))) // box overflows parent
+		boxes = append(boxes, box{kind: kind, data: data[header:size]})
+		data = data[size:]
+	}
+
+	return boxes
+}
+
+func findBox(t *testing.T, boxes []box, path ...string) []byte {
+	for _, b := range boxes {
+		if b.kind != path[0] {
+			continue
+		}
+
+		if len(path) == 1 {
+			return b.data
+		}
+
+		return findBox(t, readBoxes(t, b.data), path[1:]...)
+	}
+
+	t.Fatalf("box %v not found", path)
+	return nil
+}
+
+func TestWriter(t *testing.T) {
+	is := is.New(t)
+
+	path := filepath.Join(t.TempDir(), "out.mp4")
+	f, err := os.Create(path)
+	is.NoErr(err)
+
+	w, err := NewWriter(f, 720, 1280)
+	is.NoErr(err)
+
+	key := []byte{0, 0, 0, 1, 0x67, 0x42, 0xC0, 0x1F, 0, 0, 0, 1, 0x68, 0xCE, 0, 0, 1, 0x65, 0xAA, 0xBB}
+	is.NoErr(w.WriteFrame(key, true, 0))
+	is.NoErr(w.WriteFrame([]byte{0, 0, 1, 0x41, 0xCC}, false, 40*time.Millisecond))
+	is.NoErr(w.WriteFrame([]byte{0, 0, 1, 0x41, 0xDD}, false, 80*time.Millisecond))
+	is.Equal(w.Duration(), 80*time.Millisecond)
+	is.NoErr(w.Close())
+	is.NoErr(f.Close())
+
+	data, err := os.ReadFile(path)
+	is.NoErr(err)
+
+	top := readBoxes(t, data)
+	is.Equal(len(top), 3)
+	is.Equal(top[0].kind, "ftyp")
+	is.Equal(top[1].kind, "mdat")
+	is.Equal(top[2].kind, "moov")
+
+	// Samples are stored length prefixed, without parameter sets
+	is.Equal(top[1].data, []byte{
+		0, 0, 0, 3, 0x65, 0xAA, 0xBB,
+		0, 0, 0, 2, 0x41, 0xCC,
+		0, 0, 0, 2, 0x41, 0xDD,
+	})
+
+	stbl := []string{"moov", "trak", "mdia", "minf", "stbl"}
+
+	mdhd := findBox(t, top, "moov", "trak", "mdia", "mdhd")
+	is.Equal(binary.BigEndian.Uint32(mdhd[16:]), uint32(120))
+
+	stsd := findBox(t, top, append(stbl, "stsd")...)
+	avc1 := readBoxes(t, stsd[8:])[0]
+	is.Equal(avc1.kind, "avc1")
+	is.Equal(binary.BigEndian.Uint16(avc1.data[24:]), uint16(720))
+	is.Equal(binary.BigEndian.Uint16(avc1.data[26:]), uint16(1280))
+
+	avcC := findBox(t, readBoxes(t, avc1.data[78:]), "avcC")
+	is.Equal(avcC[1:4], []byte{0x42, 0xC0, 0x1F})
+	is.True(bytes.Contains(avcC, []byte{0x68, 0xCE}))
+
+	stts := findBox(t, top, append(stbl, "stts")...)
+	is.Equal(stts[4:], []byte{0, 0, 0, 1, 0, 0, 0, 3, 0, 0, 0, 40})
+
+	stss := findBox(t, top, append(stbl, "stss")...)
+	is.Equal(stss[4:], []byte{0, 0, 0, 1, 0, 0, 0, 1})
+
+	co64 := findBox(t, top, append(stbl, "co64")...)
+	is.Equal(binary.BigEndian.Uint64(co64[8:]), uint64(len(data)-len(top[2].data)-8-len(top[1].data)))
+}
+
+func TestWriter_Resize(t *testing.T) {
+	is := is.New(t)
+
+	path := filepath.Join(t.TempDir(), "out.mp4")
+	f, err := os.Create(path)
+	is.NoErr(err)
+
+	w, err := NewWriter(f, 720, 1280)
+	is.NoErr(err)
+
+	params := []byte{0, 0, 0, 1, 0x67, 0x42, 0xC0, 0x1F, 0, 0, 0, 1, 0x68, 0xCE}
+	key := append(params, 0, 0, 1, 0x65, 0xAA)
+	is.NoErr(w.WriteFrame(key, true, 0))
+	is.NoErr(w.WriteFrame([]byte{0, 0, 1, 0x41, 0xCC}, false, 40*time.Millisecond))
+
+	// Repeated parameter sets do not start a new description
+	is.NoErr(w.WriteFrame(key, true, 80*time.Millisecond))
+
+	w.Resize(1280, 720)
+	rotated := []byte{0, 0, 0, 1, 0x67, 0x42, 0xC0, 0x28, 0, 0, 0, 1, 0x68, 0xCF, 0, 0, 1, 0x65, 0xBB}
+	is.NoErr(w.WriteFrame(rotated, true, 120*time.Millisecond))
+	is.NoErr(w.WriteFrame([]byte{0, 0, 1, 0x41, 0xDD}, false, 160*time.Millisecond))
+	is.NoErr(w.Close())
+	is.NoErr(f.Close())
+
+	data, err := os.ReadFile(path)
+	is.NoErr(err)
+
+	top := readBoxes(t, data)
+	stbl := []string{"moov", "trak", "mdia", "minf", "stbl"}
+
+	stsd := findBox(t, top, append(stbl, "stsd")...)
+	is.Equal(binary.BigEndian.Uint32(stsd[4:]), uint32(2))
+
+	descriptions := readBoxes(t, stsd[8:])
+	is.Equal(len(descriptions), 2)
+	is.Equal(binary.BigEndian.Uint16(descriptions[0].data[24:]), uint16(720))
+	is.Equal(binary.BigEndian.Uint16(descriptions[1].data[24:]), uint16(1280))
+	is.Equal(binary.BigEndian.Uint16(descriptions[1].data[26:]), uint16(720))
+
+	avcC := findBox(t, readBoxes(t, descriptions[1].data[78:]), "avcC")
+	is.Equal(avcC[1:4], []byte{0x42, 0xC0, 0x28})
+	is.True(bytes.Contains(avcC, []byte{0x68, 0xCF}))
+
+	// The first three samples use the first description, the rest the second
+	stsc := findBox(t, top, append(stbl, "stsc")...)
+	is.Equal(stsc[4:], []byte{
+		0, 0, 0, 2,
+		0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0, 1,
+		0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 2,
+	})
+}
+
+func TestWriter_MissingParameters(t *testing.T) {
+	is := is.New(t)
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "out.mp4"))
+	is.NoErr(err)
+	defer f.Close()
+
+	w, err := NewWriter(f, 320, 240)
+	is.NoErr(err)
+
+	is.NoErr(w.WriteFrame([]byte{0, 0, 1, 0x41, 0xCC}, false, 0))
+	is.Equal(w.Close(), ErrMissingParameter)
+	is.Equal(w.WriteFrame([]byte{0, 0, 1, 0x41, 0xCC}, false, time.Second), ErrClosed)
+}
@@ -0,0 +1,189 @@
+package ringbuffer
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var Closed = errors.New("subscriber closed")
+
+// Buffer retains the most recent entries appended to it, delivering new entries to subscribers.
+type Buffer[T interface{}] struct {
+	mu          sync.Mutex
+	entries     []T
+	start       int
+	count       int
+	subscribers map[*Subscriber[T]]struct{}
+	closed      bool
+}
+
+// Subscriber receives the entries appended to a buffer. Entries are queued up to a limit, after which the oldest queued
+// entries are dropped.
+type Subscriber[T interface{}] struct {
+	buffer    *Buffer[T]
+	mu        sync.Mutex
+	pending   []T
+	limit     int
+	dropped   uint64
+	notify    chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func New[T interface{}](capacity int) *Buffer[T] {
+	return &Buffer[T]{
+		entries:     make([]T, capacity),
+		subscribers: make(map[*Subscriber[T]]struct{}),
+	}
+}
+
+func (b *Buffer[T]) Append(entry T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.entries) > 0 {
+		if b.count < len(b.entries) {
+			b.entries[(b.start+b.count)%len(b.entries)] = entry
+			b.count++
+		} else {
+			b.entries[b.start] = entry
+			b.start = (b.start + 1) % len(b.entries)
+		}
+	}
+
+	for s := range b.subscribers {
+		s.push(entry)
+	}
+}
+
+// Entries returns the retained entries, oldest first.
+func (b *Buffer[T]) Entries() []T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.entriesLocked()
+}
+
+func (b *Buffer[T]) entriesLocked() []T {
+	result := make([]T, b.count)
+	for i := 0; i < b.count; i++ {
+		result[i] = b.entries[(b.start+i)%len(b.entries)]
+	}
+
+	return result
+}
+
+// Subscribe creates a subscriber that queues up to limit entries. When replay is set, the most recent retained entries,
+// up to the limit, are queued before any new entries. Entries not replayed are not counted as dropped.
+func (b *Buffer[T]) Subscribe(limit int, replay bool) *Subscriber[T] {
+	s := &Subscriber[T]{
+		buffer: b,
+		limit:  limit,
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if replay {
+		entries := b.entriesLocked()
+		if len(entries) > limit {
+			entries = entries[len(entries)-limit:]
+		}
+
+		for _, entry := range entries {
+			s.push(entry)
+		}
+	}
+
+	// Subscribers of a closed buffer only receive the replayed entries
+	if b.closed {
+		s.close()
+		return s
+	}
+
+	b.subscribers[s] = struct{}{}
+
+	return s
+}
+
+// Close closes all subscribers. Entries already queued for a subscriber are still received.
+func (b *Buffer[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	for s := range b.subscribers {
+		s.close()
+	}
+
+	b.subscribers = make(map[*Subscriber[T]]struct{})
+}
+
+func (s *Subscriber[T]) push(entry T) {
+	s.mu.Lock()
+
+	if len(s.pending) >= s.limit {
+		s.pending = s.pending[1:]
+		s.dropped++
+	}
+
+	s.pending = append(s.pending, entry)
+
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Next blocks until an entry is available, the context is cancelled or the subscriber is closed.
+func (s *Subscriber[T]) Next(ctx context.Context) (T, error) {
+	for {
+		s.mu.Lock()
+		if len(s.pending) > 0 {
+			entry := s.pending[0]
+			s.pending = s.pending[1:]
+			s.mu.Unlock()
+
+			return entry, nil
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.notify:
+		case <-s.done:
+			var empty T
+			return empty, Closed
+		case <-ctx.Done():
+			var empty T
+			return empty, ctx.Err()
+		}
+	}
+}
+
+// Dropped returns the number of entries dropped as the subscriber fell behind.
+func (s *Subscriber[T]) Dropped() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.dropped
+}
+
+func (s *Subscriber[T]) Close() {
+	s.buffer.mu.Lock()
+	delete(s.buffer.subscribers, s)
+	s.buffer.mu.Unlock()
+
+	s.close()
+}
+
+func (s *Subscriber[T]) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+}
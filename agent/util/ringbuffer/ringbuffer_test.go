@@ -0,0 +1,94 @@
+package ringbuffer
+
+import (
+	"context"
+	"github.com/matryer/is"
+	"testing"
+	"time"
+)
+
+func TestBuffer_Entries(t *testing.T) {
+	is := is.New(t)
+	b := New[int](3)
+
+	b.Append(1)
+	b.Append(2)
+	is.Equal(b.Entries(), []int{1, 2})
+
+	b.Append(3)
+	b.Append(4)
+	is.Equal(b.Entries(), []int{2, 3, 4})
+}
+
+func TestSubscriber_Next(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	b := New[int](2)
+
+	b.Append(1)
+	b.Append(2)
+
+	s := b.Subscribe(2, true)
+	b.Append(3)
+
+	// The oldest entry is dropped once the subscriber is full
+	is.Equal(s.Dropped(), uint64(1))
+
+	value, err := s.Next(ctx)
+	is.NoErr(err)
+	is.Equal(value, 2)
+
+	value, err = s.Next(ctx)
+	is.NoErr(err)
+	is.Equal(value, 3)
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	_, err = s.Next(timeout)
+	is.Equal(err, context.DeadlineExceeded)
+
+	s.Close()
+
+	_, err = s.Next(ctx)
+	is.Equal(err, Closed)
+}
+
+func TestBuffer_SubscribeReplay(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	b := New[int](3)
+
+	b.Append(1)
+	b.Append(2)
+	b.Append(3)
+
+	// Only the most recent entries fit, which must not be reported as dropped
+	s := b.Subscribe(2, true)
+	is.Equal(s.Dropped(), uint64(0))
+
+	value, err := s.Next(ctx)
+	is.NoErr(err)
+	is.Equal(value, 2)
+}
+
+func TestBuffer_Close(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	b := New[int](2)
+
+	s := b.Subscribe(2, false)
+	b.Append(1)
+	b.Close()
+
+	// Queued entries are received before the subscriber reports it is closed
+	value, err := s.Next(ctx)
+	is.NoErr(err)
+	is.Equal(value, 1)
+
+	_, err = s.Next(ctx)
+	is.Equal(err, Closed)
+
+	_, err = b.Subscribe(2, false).Next(ctx)
+	is.Equal(err, Closed)
+}
@@ -2,6 +2,7 @@ package vpx
 
 import (
 	"errors"
+	"image"
 	"log"
 	"unsafe"
 )
@@ -13,6 +14,7 @@ import (
 #include <vpx/vpx_decoder.h>
 #include <vpx/vp8.h>
 #include <vpx/vp8cx.h>
+#include <vpx/vp8dx.h>
 #include <stdlib.h>
 #include <string.h>
 
@@ -28,6 +30,12 @@ vpx_codec_err_t vpx_codec_enc_init_cust(vpx_codec_ctx_t *ctx,
     return vpx_codec_enc_init_ver(ctx, iface, cfg, flags, VPX_ENCODER_ABI_VERSION);
 }
 
+vpx_codec_err_t vpx_codec_dec_init_cust(vpx_codec_ctx_t *ctx,
+                                       vpx_codec_iface_t *iface,
+                                       vpx_codec_flags_t flags) {
+    return vpx_codec_dec_init_ver(ctx, iface, NULL, flags, VPX_DECODER_ABI_VERSION);
+}
+
 int vpx_img_plane_width(const vpx_image_t *img, int plane) {
     if (plane > 0 && img->x_chroma_shift > 0)
         return (img->d_w + 1) >> img->x_chroma_shift;
@@ -147,6 +155,43 @@ func (c *CodecCtx) GetFrameBuffer(iter *CodecIter) []byte {
 	return C.GoBytes(buffer.ptr, buffer.size)
 }
 
+func (c *CodecCtx) DecInit(iface *CodecIface, flags int) error {
+	return convertCodecError(C.vpx_codec_dec_init_cust(
+		(*C.vpx_codec_ctx_t)(c),
+		(*C.vpx_codec_iface_t)(iface),
+		(C.vpx_codec_flags_t)(flags),
+	))
+}
+
+func (c *CodecCtx) Decode(data []byte) error {
+	if len(data) == 0 {
+		return CorruptFrame
+	}
+
+	return convertCodecError(C.vpx_codec_decode(
+		(*C.vpx_codec_ctx_t)(c),
+		(*C.uint8_t)(unsafe.Pointer(&data[0])),
+		(C.uint)(len(data)),
+		nil,
+		0,
+	))
+}
+
+// GetFrame returns the next decoded image, or nil when no more images are available. The image is owned by the
+// decoder and is only valid until the next call to Decode.
+func (c *CodecCtx) GetFrame(iter *CodecIter) *Image {
+	return (*Image)(C.vpx_codec_get_frame(
+		(*C.vpx_codec_ctx_t)(c),
+		(*C.vpx_codec_iter_t)(iter),
+	))
+}
+
+func (c *CodecCtx) Destroy() error {
+	return convertCodecError(C.vpx_codec_destroy(
+		(*C.vpx_codec_ctx_t)(c),
+	))
+}
+
 func (c *CodecCtx) Free() {
 	C.free(unsafe.Pointer(c))
 }
@@ -192,6 +237,14 @@ func VP8Iface() *CodecIface {
 	return (*CodecIface)(C.vpx_codec_vp8_cx())
 }
 
+func VP8DecIface() *CodecIface {
+	return (*CodecIface)(C.vpx_codec_vp8_dx())
+}
+
+func VP9DecIface() *CodecIface {
+	return (*CodecIface)(C.vpx_codec_vp9_dx())
+}
+
 func convertCodecError(err C.vpx_codec_err_t) error {
 	switch err {
 	case C.VPX_CODEC_OK:
@@ -254,6 +307,39 @@ func (i *Image) Read(data []byte) {
 	)
 }
 
+func (i *Image) Width() uint32 {
+	return uint32(i.d_w)
+}
+
+func (i *Image) Height() uint32 {
+	return uint32(i.d_h)
+}
+
+// ToYCbCr copies an 8-bit I420 image into Go memory.
+func (i *Image) ToYCbCr() (*image.YCbCr, error) {
+	if ImageFormat(i.fmt) != ImageFormatI420 {
+		return nil, UnsupportedFeature
+	}
+
+	img := image.NewYCbCr(image.Rect(0, 0, int(i.d_w), int(i.d_h)), image.YCbCrSubsampleRatio420)
+	copyPlane(img.Y, img.YStride, i, 0)
+	copyPlane(img.Cb, img.CStride, i, 1)
+	copyPlane(img.Cr, img.CStride, i, 2)
+
+	return img, nil
+}
+
+func copyPlane(dst []byte, dstStride int, i *Image, plane int) {
+	w := int(C.vpx_img_plane_width((*C.vpx_image_t)(i), C.int(plane)))
+	h := int(C.vpx_img_plane_height((*C.vpx_image_t)(i), C.int(plane)))
+	stride := int(i.stride[plane])
+	src := unsafe.Slice((*byte)(unsafe.Pointer(i.planes[plane])), stride*h)
+
+	for y := 0; y < h; y++ {
+		copy(dst[y*dstStride:y*dstStride+w], src[y*stride:y*stride+w])
+	}
+}
+
 func (i *Image) Free() {
 	C.vpx_img_free(
 		(*C.vpx_image_t)(i),
@@ -0,0 +1,325 @@
+package webm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"time"
+)
+
+// Codec represents the codec id of the video track.
+type Codec string
+
+const (
+	CodecVP8 Codec = "V_VP8"
+	CodecVP9 Codec = "V_VP9"
+)
+
+const (
+	idEBML               = 0x1A45DFA3
+	idEBMLVersion        = 0x4286
+	idEBMLReadVersion    = 0x42F7
+	idEBMLMaxIDLength    = 0x42F2
+	idEBMLMaxSizeLength  = 0x42F3
+	idDocType            = 0x4282
+	idDocTypeVersion     = 0x4287
+	idDocTypeReadVersion = 0x4285
+	idSegment            = 0x18538067
+	idInfo               = 0x1549A966
+	idTimecodeScale      = 0x2AD7B1
+	idMuxingApp          = 0x4D80
+	idWritingApp         = 0x5741
+	idDuration           = 0x4489
+	idTracks             = 0x1654AE6B
+	idTrackEntry         = 0xAE
+	idTrackNumber        = 0xD7
+	idTrackUID           = 0x73C5
+	idTrackType          = 0x83
+	idFlagLacing         = 0x9C
+	idCodecID            = 0x86
+	idVideo              = 0xE0
+	idPixelWidth         = 0xB0
+	idPixelHeight        = 0xBA
+	idCluster            = 0x1F43B675
+	idTimecode           = 0xE7
+	idSimpleBlock        = 0xA3
+
+	// Timecodes are stored in milliseconds
+	timecodeScale = 1_000_000
+
+	// The largest timecode offset a block can store relative to its cluster
+	maxBlockOffset = math.MaxInt16
+
+	appName = "droidmole"
+)
+
+// unknownSize is the reserved 8 byte encoding of an unknown element size.
+var unknownSize = []byte{0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+
+var ErrClosed = errors.New("writer closed")
+
+// Writer muxes a single video track into a WebM container.
+// If the underlying writer implements io.Seeker, the segment size and duration are filled in when the writer is closed,
+// otherwise they are left unknown.
+type Writer struct {
+	w              io.Writer
+	offset         int64
+	segmentSizePos int64
+	segmentStart   int64
+	durationPos    int64
+	cluster        bytes.Buffer
+	clusterTime    int64
+	clusterOpen    bool
+	lastTime       int64
+	closed         bool
+}
+
+// NewWriter writes the container header for a video track with the given codec and size.
+func NewWriter(w io.Writer, codec Codec, width uint32, height uint32) (*Writer, error) {
+	wr := &Writer{
+		w: w,
+	}
+
+	header := &bytes.Buffer{}
+	writeElement(header, idEBML, func(b *bytes.Buffer) {
+		writeUint(b, idEBMLVersion, 1)
+		writeUint(b, idEBMLReadVersion, 1)
+		writeUint(b, idEBMLMaxIDLength, 4)
+		writeUint(b, idEBMLMaxSizeLength, 8)
+		writeString(b, idDocType, "webm")
+		writeUint(b, idDocTypeVersion, 2)
+		writeUint(b, idDocTypeReadVersion, 2)
+	})
+
+	// The segment size is only known once all clusters have been written
+	writeID(header, idSegment)
+	wr.segmentSizePos = int64(header.Len())
+	header.Write(unknownSize)
+	wr.segmentStart = int64(header.Len())
+
+	info := &bytes.Buffer{}
+	writeUint(info, idTimecodeScale, timecodeScale)
+	writeString(info, idMuxingApp, appName)
+	writeString(info, idWritingApp, appName)
+
+	// The duration is only known once all clusters have been written
+	writeID(info, idDuration)
+	writeSize(info, 8)
+	durationOffset := info.Len()
+	writeFloat(info, 0)
+
+	infoPrefix := elementPrefix(idInfo, info.Len())
+	wr.durationPos = int64(header.Len() + len(infoPrefix) + durationOffset)
+	header.Write(infoPrefix)
+	header.Write(info.Bytes())
+
+	writeElement(header, idTracks, func(b *bytes.Buffer) {
+		writeElement(b, idTrackEntry, func(b *bytes.Buffer) {
+			writeUint(b, idTrackNumber, 1)
+			writeUint(b, idTrackUID, 1)
+			writeUint(b, idTrackType, 1)
+			writeUint(b, idFlagLacing, 0)
+			writeString(b, idCodecID, string(codec))
+			writeElement(b, idVideo, func(b *bytes.Buffer) {
+				writeUint(b, idPixelWidth, uint64(width))
+				writeUint(b, idPixelHeight, uint64(height))
+			})
+		})
+	})
+
+	err := wr.write(header.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return wr, nil
+}
+
+// WriteFrame writes a single encoded frame, presented at the given time since the start of the video.
+// Timestamps must not decrease. A new cluster is started on each keyframe.
+func (w *Writer) WriteFrame(data []byte, keyframe bool, timestamp time.Duration) error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	ms := timestamp.Milliseconds()
+	if ms < w.lastTime {
+		ms = w.lastTime
+	}
+
+	w.lastTime = ms
+
+	if !w.clusterOpen || (keyframe && w.cluster.Len() > 0) || ms-w.clusterTime > maxBlockOffset {
+		err := w.flushCluster()
+		if err != nil {
+			return err
+		}
+
+		w.clusterOpen = true
+		w.clusterTime = ms
+		writeUint(&w.cluster, idTimecode, uint64(ms))
+	}
+
+	var flags byte
+	if keyframe {
+		flags |= 0x80
+	}
+
+	// Track number, relative timecode and flags
+	block := make([]byte, 4, 4+len(data))
+	block[0] = 0x81
+	binary.BigEndian.PutUint16(block[1:3], uint16(int16(ms-w.clusterTime)))
+	block[3] = flags
+	block = append(block, data...)
+
+	writeBytes(&w.cluster, idSimpleBlock, block)
+
+	return nil
+}
+
+// Duration returns the timestamp of the last written frame.
+func (w *Writer) Duration() time.Duration {
+	return time.Duration(w.lastTime) * time.Millisecond
+}
+
+// Close flushes any buffered frames and, if possible, fills in the segment size and duration.
+// The underlying writer is not closed.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	w.closed = true
+
+	err := w.flushCluster()
+	if err != nil {
+		return err
+	}
+
+	seeker, ok := w.w.(io.Seeker)
+	if !ok {
+		return nil
+	}
+
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(w.offset-w.segmentStart))
+	size[0] = 0x01
+
+	err = writeAt(w.w, seeker, w.segmentSizePos, size)
+	if err != nil {
+		return err
+	}
+
+	duration := make([]byte, 8)
+	binary.BigEndian.PutUint64(duration, math.Float64bits(float64(w.lastTime)))
+
+	err = writeAt(w.w, seeker, w.durationPos, duration)
+	if err != nil {
+		return err
+	}
+
+	_, err = seeker.Seek(w.offset, io.SeekStart)
+	return err
+}
+
+func (w *Writer) flushCluster() error {
+	if !w.clusterOpen {
+		return nil
+	}
+
+	w.clusterOpen = false
+
+	err := w.write(elementPrefix(idCluster, w.cluster.Len()))
+	if err != nil {
+		return err
+	}
+
+	err = w.write(w.cluster.Bytes())
+	w.cluster.Reset()
+	return err
+}
+
+func (w *Writer) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.offset += int64(n)
+	return err
+}
+
+func writeAt(w io.Writer, seeker io.Seeker, pos int64, data []byte) error {
+	_, err := seeker.Seek(pos, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func writeID(b *bytes.Buffer, id uint32) {
+	switch {
+	case id >= 1<<24:
+		b.Write([]byte{byte(id >> 24), byte(id >> 16), byte(id >> 8), byte(id)})
+	case id >= 1<<16:
+		b.Write([]byte{byte(id >> 16), byte(id >> 8), byte(id)})
+	case id >= 1<<8:
+		b.Write([]byte{byte(id >> 8), byte(id)})
+	default:
+		b.WriteByte(byte(id))
+	}
+}
+
+// writeSize writes a variable length size using the minimum number of bytes.
+func writeSize(b *bytes.Buffer, size int) {
+	length := 1
+	for length < 8 && uint64(size) >= (1<<(7*length))-1 {
+		length++
+	}
+
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, uint64(size)|(1<<(7*length)))
+	b.Write(encoded[8-length:])
+}
+
+func elementPrefix(id uint32, size int) []byte {
+	b := &bytes.Buffer{}
+	writeID(b, id)
+	writeSize(b, size)
+	return b.Bytes()
+}
+
+func writeElement(b *bytes.Buffer, id uint32, body func(b *bytes.Buffer)) {
+	inner := &bytes.Buffer{}
+	body(inner)
+	writeBytes(b, id, inner.Bytes())
+}
+
+func writeBytes(b *bytes.Buffer, id uint32, data []byte) {
+	writeID(b, id)
+	writeSize(b, len(data))
+	b.Write(data)
+}
+
+func writeUint(b *bytes.Buffer, id uint32, value uint64) {
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, value)
+
+	// Trim leading zeros, keeping at least one byte
+	i := 0
+	for i < 7 && encoded[i] == 0 {
+		i++
+	}
+
+	writeBytes(b, id, encoded[i:])
+}
+
+func writeFloat(b *bytes.Buffer, value float64) {
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, math.Float64bits(value))
+	b.Write(encoded)
+}
+
+func writeString(b *bytes.Buffer, id uint32, value string) {
+	writeBytes(b, id, []byte(value))
+}
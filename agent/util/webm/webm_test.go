@@ -0,0 +1,128 @@
+package webm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/matryer/is"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type element struct {
+	id   uint32
+	data []byte
+}
+
+// readElements parses a sequence of EBML elements with known sizes.
+func readElements(t *testing.T, data []byte) []element {
+	is := is.New(t)
+
+	var elements []element
+	for len(data) > 0 {
+		idLen := 1
+		for idLen < 4 && data[0]&(0x80>>(idLen-1)) == 0 {
+			idLen++
+		}
+
+		var id uint32
+		for _, b := range data[:idLen] {
+			id = id<<8 | uint32(b)
+		}
+		data = data[idLen:]
+
+		sizeLen := 1
+		for sizeLen < 8 && data[0]&(0x80>>(sizeLen-1)) == 0 {
+			sizeLen++
+		}
+
+		size := uint64(data[0] & (0xFF >> sizeLen))
+		for _, b := range data[1:sizeLen] {
+			size = size<<8 | uint64(b)
+		}
+		data = data[sizeLen:]
+
+		is.True(size <= uint64(len(data))) // element overflows parent
+		elements = append(elements, element{id: id, data: data[:size]})
+		data = data[size:]
+	}
+
+	return elements
+}
+
+func findElement(elements []element, id uint32) []element {
+	var found []element
+	for _, e := range elements {
+		if e.id == id {
+			found = append(found, e)
+		}
+	}
+	return found
+}
+
+func TestWriter(t *testing.T) {
+	is := is.New(t)
+
+	path := filepath.Join(t.TempDir(), "out.webm")
+	f, err := os.Create(path)
+	is.NoErr(err)
+
+	w, err := NewWriter(f, CodecVP8, 720, 1280)
+	is.NoErr(err)
+
+	is.NoErr(w.WriteFrame([]byte("key1"), true, 0))
+	is.NoErr(w.WriteFrame([]byte("delta1"), false, 40*time.Millisecond))
+	is.NoErr(w.WriteFrame([]byte("key2"), true, 2*time.Second))
+	is.NoErr(w.Close())
+	is.NoErr(f.Close())
+
+	data, err := os.ReadFile(path)
+	is.NoErr(err)
+
+	top := readElements(t, data)
+	is.Equal(len(top), 2)
+	is.Equal(top[0].id, uint32(idEBML))
+	is.Equal(top[1].id, uint32(idSegment))
+
+	header := readElements(t, top[0].data)
+	is.Equal(string(findElement(header, idDocType)[0].data), "webm")
+
+	segment := readElements(t, top[1].data)
+
+	info := readElements(t, findElement(segment, idInfo)[0].data)
+	duration := findElement(info, idDuration)[0].data
+	is.Equal(math.Float64frombits(binary.BigEndian.Uint64(duration)), float64(2000))
+
+	tracks := readElements(t, findElement(segment, idTracks)[0].data)
+	entry := readElements(t, tracks[0].data)
+	is.Equal(string(findElement(entry, idCodecID)[0].data), "V_VP8")
+
+	clusters := findElement(segment, idCluster)
+	is.Equal(len(clusters), 2) // one cluster per keyframe
+
+	first := readElements(t, clusters[0].data)
+	blocks := findElement(first, idSimpleBlock)
+	is.Equal(len(blocks), 2)
+	is.Equal(blocks[0].data, []byte{0x81, 0, 0, 0x80, 'k', 'e', 'y', '1'})
+	is.Equal(blocks[1].data[1:4], []byte{0, 40, 0})
+
+	second := readElements(t, clusters[1].data)
+	is.Equal(findElement(second, idTimecode)[0].data, []byte{0x07, 0xD0})
+}
+
+func TestWriter_Unseekable(t *testing.T) {
+	is := is.New(t)
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, CodecVP9, 320, 240)
+	is.NoErr(err)
+
+	is.NoErr(w.WriteFrame([]byte("key"), true, 0))
+	is.NoErr(w.Close())
+	is.Equal(w.WriteFrame([]byte("late"), false, time.Second), ErrClosed)
+
+	// The segment size remains unknown
+	is.True(bytes.Contains(buf.Bytes(), unknownSize))
+}
@@ -0,0 +1,196 @@
+package x264
+
+import (
+	"errors"
+	"log"
+	"unsafe"
+)
+
+/*
+#cgo pkg-config: x264
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+#include <x264.h>
+
+typedef struct BufferWrapper {
+    void *ptr;
+    int size;
+} BufferWrapperType;
+
+int x264_param_default_realtime(x264_param_t *param, int width, int height, int fps, int bitrate) {
+    if (x264_param_default_preset(param, "ultrafast", "zerolatency") < 0)
+        return -1;
+
+    param->i_csp = X264_CSP_I420;
+    param->i_width = width;
+    param->i_height = height;
+    param->i_fps_num = fps;
+    param->i_fps_den = 1;
+
+    // Keyframes are only produced when requested
+    param->i_keyint_max = X264_KEYINT_MAX_INFINITE;
+    param->i_scenecut_threshold = 0;
+
+    // Emit SPS and PPS with every keyframe, as an Annex B byte stream
+    param->b_repeat_headers = 1;
+    param->b_annexb = 1;
+
+    param->rc.i_rc_method = X264_RC_ABR;
+    param->rc.i_bitrate = bitrate;
+
+    return x264_param_apply_profile(param, "baseline");
+}
+
+void x264_picture_read(x264_picture_t *pic, int width, int height, void *blob) {
+    int plane;
+
+    for (plane = 0; plane < 3; ++plane) {
+        unsigned char *buf = pic->img.plane[plane];
+        const int stride = pic->img.i_stride[plane];
+        const int w = plane == 0 ? width : (width + 1) >> 1;
+        const int h = plane == 0 ? height : (height + 1) >> 1;
+        int y;
+
+        for (y = 0; y < h; ++y) {
+            memcpy(buf, blob, w);
+            buf += stride;
+            blob += w;
+        }
+    }
+}
+
+BufferWrapperType x264_encoder_encode_frame(x264_t *h, x264_picture_t *pic, int64_t pts, int keyframe) {
+    BufferWrapperType buffer = {NULL, 0};
+    x264_picture_t pic_out;
+    x264_nal_t *nals;
+    int nal_count;
+
+    pic->i_pts = pts;
+    pic->i_type = keyframe ? X264_TYPE_IDR : X264_TYPE_AUTO;
+
+    // NALs produced by a single call are stored contiguously
+    int size = x264_encoder_encode(h, &nals, &nal_count, pic, &pic_out);
+    if (size > 0) {
+        buffer.ptr = nals[0].p_payload;
+        buffer.size = size;
+    } else if (size < 0) {
+        buffer.size = -1;
+    }
+    return buffer;
+}
+*/
+import "C"
+
+type Param C.x264_param_t
+type Encoder C.x264_t
+type Picture C.x264_picture_t
+
+const paramSize = unsafe.Sizeof([1]C.x264_param_t{})
+const pictureSize = unsafe.Sizeof([1]C.x264_picture_t{})
+
+var (
+	InvalidParam = errors.New("invalid param")
+	OpenError    = errors.New("failed to open encoder")
+	AllocError   = errors.New("failed to allocate picture")
+	EncodeError  = errors.New("failed to encode frame")
+)
+
+func allocMemory(size uintptr) unsafe.Pointer {
+	ptr, err := C.calloc(C.size_t(1), (C.size_t)(size))
+	if err != nil {
+		log.Fatal("allocation error", err.Error())
+	}
+
+	return ptr
+}
+
+func NewParam() *Param {
+	return (*Param)(allocMemory(paramSize))
+}
+
+// DefaultRealtime configures the parameters for low latency encoding using the baseline profile.
+// The bitrate is specified in kbit/s.
+func (p *Param) DefaultRealtime(width int, height int, fps int, bitrate int) error {
+	res := C.x264_param_default_realtime(
+		(*C.x264_param_t)(p),
+		C.int(width),
+		C.int(height),
+		C.int(fps),
+		C.int(bitrate),
+	)
+	if res < 0 {
+		return InvalidParam
+	}
+
+	return nil
+}
+
+func (p *Param) Free() {
+	C.free(unsafe.Pointer(p))
+}
+
+func Open(param *Param) (*Encoder, error) {
+	enc := C.x264_encoder_open((*C.x264_param_t)(param))
+	if enc == nil {
+		return nil, OpenError
+	}
+
+	return (*Encoder)(enc), nil
+}
+
+// Encode encodes a single picture, returning the Annex B byte stream produced. A nil result signifies the encoder
+// buffered the picture.
+func (e *Encoder) Encode(pic *Picture, pts int64, keyframe bool) ([]byte, error) {
+	kf := C.int(0)
+	if keyframe {
+		kf = 1
+	}
+
+	buffer := C.x264_encoder_encode_frame(
+		(*C.x264_t)(e),
+		(*C.x264_picture_t)(pic),
+		C.int64_t(pts),
+		kf,
+	)
+	if buffer.size < 0 {
+		return nil, EncodeError
+	}
+
+	if buffer.ptr == nil {
+		return nil, nil
+	}
+
+	return C.GoBytes(buffer.ptr, buffer.size), nil
+}
+
+func (e *Encoder) Close() {
+	C.x264_encoder_close((*C.x264_t)(e))
+}
+
+func NewPicture(width int, height int) (*Picture, error) {
+	pic := (*Picture)(allocMemory(pictureSize))
+
+	res := C.x264_picture_alloc((*C.x264_picture_t)(pic), C.X264_CSP_I420, C.int(width), C.int(height))
+	if res < 0 {
+		C.free(unsafe.Pointer(pic))
+		return nil, AllocError
+	}
+
+	return pic, nil
+}
+
+// Read copies a contiguous I420 frame into the picture planes.
+func (p *Picture) Read(width int, height int, data []byte) {
+	C.x264_picture_read(
+		(*C.x264_picture_t)(p),
+		C.int(width),
+		C.int(height),
+		unsafe.Pointer(&data[0]),
+	)
+}
+
+func (p *Picture) Free() {
+	C.x264_picture_clean((*C.x264_picture_t)(p))
+	C.free(unsafe.Pointer(p))
+}
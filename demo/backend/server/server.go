@@ -85,6 +85,12 @@ func (s *Server) wsEndpoint(w http.ResponseWriter, r *http.Request) {
 	s.mu.Lock()
 	s.clients = append(s.clients, c)
 	s.mu.Unlock()
+
+	// Resynchronise the shared stream so the new client does not wait for the next keyframe interval
+	err = s.displayStream.RequestKeyframe(r.Context())
+	if err != nil {
+		log.Println("keyframe request:", err)
+	}
 }
 
 func (s *Server) processDisplay() {